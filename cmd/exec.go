@@ -5,6 +5,9 @@ package cmd
 
 import (
 	source "meow/source"
+	"meow/source/meow"
+	"meow/source/runner/object"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
 )
@@ -19,11 +22,17 @@ and usage of using your command. For example:
 Cobra is a CLI library for Go that empowers applications.
 This application is a tool to generate the needed files
 to quickly create a Cobra application.`,
-	Args:    cobra.ExactArgs(1),
-	Example: `  meow exec myscript.meow`,
+	Args:    cobra.MinimumNArgs(1),
+	Example: `  meow exec myscript.meow -- a b c`,
 	Run: func(cmd *cobra.Command, args []string) {
-		filepath := args[0]
-		source.Start(filepath)
+		path := args[0]
+		// Всё после пути к файлу передаётся программе как os.args.
+		options := []meow.Option{meow.WithArgs(args[1:])}
+		if sandbox, _ := cmd.Flags().GetBool("sandbox"); sandbox {
+			// В песочнице программа может читать только файлы рядом с собой.
+			options = append(options, meow.WithCapabilities(object.Sandbox(filepath.Dir(path))))
+		}
+		source.Start(path, options...)
 	},
 }
 
@@ -65,4 +74,5 @@ func init() {
 	rootCmd.AddCommand(execCmd)
 	rootCmd.AddCommand(debugCmd)
 	rootCmd.AddCommand(tokensCmd)
+	execCmd.Flags().Bool("sandbox", false, "Запретить программе доступ к системе, кроме чтения файлов из её каталога")
 }
@@ -57,8 +57,10 @@ type AssignmentExpression struct {
 func (ae AssignmentExpression) expression() {}
 
 type ClassInstance struct {
-	ClassName string
-	Fields    map[string]Expression
+	ClassName     string
+	TypeArguments []Type
+	Parameters    []Expression
+	Fields        map[string]Expression
 }
 
 func (ci ClassInstance) expression() {}
@@ -71,15 +73,19 @@ type ArrayInstance struct {
 func (ai ArrayInstance) expression() {}
 
 type FunctionInstance struct {
-	FunctionName string
-	Parameters   []Expression
+	FunctionName    string
+	Parameters      []Expression
+	NamedParameters map[string]Expression
 }
 
 func (fi FunctionInstance) expression() {}
 
+// MemberInstance — обращение к члену объекта a.b; при IsOptional (a?.b)
+// обращение к null даёт null.
 type MemberInstance struct {
 	Instance   Expression
 	MemberName Expression
+	IsOptional bool
 }
 
 func (mi MemberInstance) expression() {}
@@ -91,6 +97,24 @@ type ArrayDeclaration struct {
 
 func (ad ArrayDeclaration) expression() {}
 
+type MapDeclaration struct {
+	Keys   []Expression
+	Values []Expression
+}
+
+func (md MapDeclaration) expression() {}
+
+type IsExpression struct {
+	Value Expression
+	Type  Type
+}
+
+func (ie IsExpression) expression() {}
+
+type NullExpression struct{}
+
+func (ne NullExpression) expression() {}
+
 type BooleanExpression struct {
 	Value bool
 }
@@ -19,36 +19,86 @@ func (es ExpressionStatement) statement() {
 type VariableDecStatement struct {
 	Names         []string
 	IsConstant    bool
+	IsVariadic    bool
 	Type          Type
 	AssignedValue Expression
 }
 
 func (vds VariableDecStatement) statement() {}
 
+type MultiAssignmentStatement struct {
+	Assignees []Expression
+	Values    []Expression
+}
+
+func (mas MultiAssignmentStatement) statement() {}
+
 type ClassFieldStatement struct {
-	IsStatic bool
-	Type     Type
+	IsStatic  bool
+	IsPrivate bool
+	Type      Type
+	Default   Expression
 }
 
 type ClassFunctionStatement struct {
-	Parameters  []Type
-	ReturnTypes []Type
-	IsStatic    bool
+	TypeParameters []string
+	Parameters     []VariableDecStatement
+	ReturnTypes    []Type
+	IsStatic       bool
+	IsPrivate      bool
+	Body           *BlockStatement
 }
 
 type ClassDecStatement struct {
+	Name           string
+	TypeParameters []string
+	Parent         string
+	Interfaces     []string
+	Fields         map[string]ClassFieldStatement
+	Functions      map[string]ClassFunctionStatement
+	Constructor    *ClassFunctionStatement
+}
+
+func (cds ClassDecStatement) statement() {}
+
+type InterfaceDecStatement struct {
 	Name      string
-	Fields    map[string]ClassFieldStatement
 	Functions map[string]ClassFunctionStatement
 }
 
-func (cds ClassDecStatement) statement() {}
+func (ids InterfaceDecStatement) statement() {}
+
+type EnumVariantStatement struct {
+	Name  string
+	Types []Type
+}
+
+type EnumDecStatement struct {
+	Name     string
+	Variants []EnumVariantStatement
+}
+
+func (eds EnumDecStatement) statement() {}
+
+type MatchArm struct {
+	Variant  string
+	Bindings []string
+	Body     *BlockStatement
+}
+
+type MatchStatement struct {
+	Value Expression
+	Arms  []MatchArm
+}
+
+func (ms MatchStatement) statement() {}
 
 type FunctionDecStatement struct {
-	Name       string
-	Parameters []VariableDecStatement
-	ReturnType []Type
-	Body       *BlockStatement
+	Name           string
+	TypeParameters []string
+	Parameters     []VariableDecStatement
+	ReturnType     []Type
+	Body           *BlockStatement
 }
 
 func (fds FunctionDecStatement) statement() {}
@@ -74,9 +124,32 @@ type WhileStatement struct {
 
 func (ws WhileStatement) statement() {}
 
+// VisibilityStatement задаёт видимость объявления верхнего уровня модуля.
+type VisibilityStatement struct {
+	IsPrivate bool
+	Statement Statement
+}
+
+func (vs VisibilityStatement) statement() {}
+
 type ImportStatement struct {
 	ImportName  string
 	PackagePath string
 }
 
 func (is ImportStatement) statement() {}
+
+type ThrowStatement struct {
+	Value Expression
+}
+
+func (ts ThrowStatement) statement() {}
+
+type TryStatement struct {
+	Body         *BlockStatement
+	CatchName    string
+	CatchBlock   *BlockStatement
+	FinallyBlock *BlockStatement
+}
+
+func (ts TryStatement) statement() {}
@@ -1,7 +1,10 @@
 package ast
 
+// SymbolType — именованный тип; Arguments задают параметры обобщённого
+// класса: Box<int>.
 type SymbolType struct {
-	Name string
+	Name      string
+	Arguments []Type
 }
 
 func (st SymbolType) func_type() {}
@@ -11,3 +14,17 @@ type ArrayType struct {
 }
 
 func (at ArrayType) func_type() {}
+
+// NullableType — тип, допускающий null: int?.
+type NullableType struct {
+	Underlying Type
+}
+
+func (nt NullableType) func_type() {}
+
+type MapType struct {
+	Key   Type
+	Value Type
+}
+
+func (mt MapType) func_type() {}
@@ -0,0 +1,714 @@
+package checker
+
+import (
+	"errors"
+	"fmt"
+	"meow/source/ast"
+	"meow/source/lexer"
+	"sort"
+	"strings"
+)
+
+type checker struct {
+	errors    []error
+	functions map[string]*ast.FunctionDecStatement
+	enums     map[string]*ast.EnumDecStatement
+	classes   map[string]*ast.ClassDecStatement
+	// nullable хранит значения, которые могут быть null, и отмечает
+	// проверенные: имена переменных и цепочки полей вида x.next.name
+	nullable map[string]bool
+	// types хранит класс переменных, чей тип известен из объявления:
+	// по нему находятся поля со знаком ? в цепочках x.next.name
+	types     map[string]string
+	thisClass string
+	// zipped хранит переменные с результатом zip: его элементы —
+	// кортежи, которые раскладываются в var a, b = pairs[i];
+	zipped map[string]bool
+}
+
+// Check выполняет статическую проверку программы до её запуска и
+// возвращает все найденные ошибки.
+func Check(program ast.BlockStatement) error {
+	c := &checker{
+		errors:    make([]error, 0),
+		functions: make(map[string]*ast.FunctionDecStatement),
+		enums:     make(map[string]*ast.EnumDecStatement),
+		classes:   make(map[string]*ast.ClassDecStatement),
+		nullable:  make(map[string]bool),
+		types:     make(map[string]string),
+		zipped:    make(map[string]bool),
+	}
+	for _, stmt := range program.Statements {
+		if visibility, ok := stmt.(*ast.VisibilityStatement); ok {
+			stmt = visibility.Statement
+		}
+		switch stmt := stmt.(type) {
+		case *ast.FunctionDecStatement:
+			c.functions[stmt.Name] = stmt
+		case *ast.EnumDecStatement:
+			c.enums[stmt.Name] = stmt
+		case *ast.ClassDecStatement:
+			c.classes[stmt.Name] = stmt
+		}
+	}
+	c.checkBlock(&program)
+	return errors.Join(c.errors...)
+}
+
+func (c *checker) errorf(format string, a ...any) {
+	c.errors = append(c.errors, fmt.Errorf(format, a...))
+}
+
+func (c *checker) checkBlock(block *ast.BlockStatement) {
+	if block == nil {
+		return
+	}
+	for _, stmt := range block.Statements {
+		c.checkStatement(stmt)
+	}
+}
+
+func (c *checker) checkStatement(stmt ast.Statement) {
+	switch stmt := stmt.(type) {
+	case *ast.BlockStatement:
+		c.checkBlock(stmt)
+	case *ast.ExpressionStatement:
+		c.checkExpression(stmt.Expression)
+	case *ast.VariableDecStatement:
+		c.checkExpression(stmt.AssignedValue)
+		if stmt.AssignedValue != nil {
+			c.checkValueCount(len(stmt.Names), []ast.Expression{stmt.AssignedValue})
+		}
+		for _, name := range stmt.Names {
+			_, isNullable := stmt.Type.(*ast.NullableType)
+			c.nullable[name] = isNullable || (len(stmt.Names) == 1 && c.mayBeNull(stmt.AssignedValue))
+			c.forget(name)
+			delete(c.types, name)
+			delete(c.zipped, name)
+			if len(stmt.Names) == 1 {
+				c.setType(name, stmt.Type, stmt.AssignedValue)
+				c.zipped[name] = c.isZipped(stmt.AssignedValue)
+			}
+		}
+	case *ast.MultiAssignmentStatement:
+		for _, expr := range stmt.Assignees {
+			c.checkExpression(expr)
+		}
+		for _, expr := range stmt.Values {
+			c.checkExpression(expr)
+		}
+		c.checkValueCount(len(stmt.Assignees), stmt.Values)
+	case *ast.FunctionDecStatement:
+		typeParams := c.checkTypeParameters(stmt.Name, stmt.TypeParameters, nil)
+		c.checkFunction(stmt.Name, typeParams, stmt.Parameters, stmt.Body)
+	case *ast.ClassDecStatement:
+		classTypeParams := c.checkTypeParameters(stmt.Name, stmt.TypeParameters, nil)
+		c.thisClass = stmt.Name
+		defer func() { c.thisClass = "" }()
+		for name, field := range stmt.Fields {
+			if field.Default == nil {
+				continue
+			}
+			if !literalMatchesType(field.Default, field.Type, classTypeParams) {
+				c.errorf("Значение по умолчанию поля %s класса %s не соответствует типу", name, stmt.Name)
+			}
+			c.checkExpression(field.Default)
+		}
+		for name, fn := range stmt.Functions {
+			// статическим методам параметры типа класса не видны
+			outer := classTypeParams
+			if fn.IsStatic {
+				outer = nil
+			}
+			typeParams := c.checkTypeParameters(stmt.Name+"."+name, fn.TypeParameters, outer)
+			c.checkFunction(stmt.Name+"."+name, typeParams, fn.Parameters, fn.Body)
+		}
+		if stmt.Constructor != nil {
+			c.checkFunction(stmt.Name+".constructor", classTypeParams, stmt.Constructor.Parameters, stmt.Constructor.Body)
+		}
+	case *ast.ReturnStatement:
+		for _, expr := range stmt.Expressions {
+			c.checkExpression(expr)
+		}
+	case *ast.IfStatement:
+		c.checkExpression(stmt.Condition)
+		outer := c.nullable
+		c.nullable = narrowed(outer, nonNullWhen(stmt.Condition, true))
+		c.checkBlock(stmt.ThenBlock)
+		c.nullable = narrowed(outer, nonNullWhen(stmt.Condition, false))
+		c.checkBlock(stmt.ElseBlock)
+		c.nullable = outer
+		// после if (x == null) ( return; ); значение x уже проверено
+		if terminates(stmt.ThenBlock) {
+			c.nullable = narrowed(outer, nonNullWhen(stmt.Condition, false))
+		} else if stmt.ElseBlock != nil && terminates(stmt.ElseBlock) {
+			c.nullable = narrowed(outer, nonNullWhen(stmt.Condition, true))
+		}
+	case *ast.WhileStatement:
+		for _, expr := range stmt.Conditions {
+			c.checkExpression(expr)
+		}
+		outer := c.nullable
+		for _, expr := range stmt.Conditions {
+			c.nullable = narrowed(c.nullable, nonNullWhen(expr, true))
+		}
+		c.checkBlock(stmt.Body)
+		c.nullable = outer
+	case *ast.VisibilityStatement:
+		switch stmt.Statement.(type) {
+		case *ast.FunctionDecStatement, *ast.VariableDecStatement, *ast.ClassDecStatement, *ast.InterfaceDecStatement,
+			*ast.EnumDecStatement:
+		default:
+			c.errorf("Модификаторы public и private применимы только к объявлениям")
+		}
+		c.checkStatement(stmt.Statement)
+	case *ast.ThrowStatement:
+		c.checkExpression(stmt.Value)
+	case *ast.TryStatement:
+		c.checkBlock(stmt.Body)
+		c.checkBlock(stmt.CatchBlock)
+		c.checkBlock(stmt.FinallyBlock)
+	case *ast.MatchStatement:
+		c.checkExpression(stmt.Value)
+		c.checkMatch(stmt)
+		for _, arm := range stmt.Arms {
+			c.checkBlock(arm.Body)
+		}
+	}
+}
+
+// checkMatch проверяет ветки match. Перечисление определяется по именам
+// вариантов; если оно объявлено в этом же файле, проверяется полнота
+// и число имён в каждой ветке.
+func (c *checker) checkMatch(stmt *ast.MatchStatement) {
+	seen := make(map[string]bool)
+	wildcard := false
+	var variants []string
+	for _, arm := range stmt.Arms {
+		if arm.Variant == "_" {
+			if wildcard {
+				c.errorf("Ветка _ указана в match дважды")
+			}
+			wildcard = true
+			continue
+		}
+		if seen[arm.Variant] {
+			c.errorf("Вариант %s указан в match дважды", arm.Variant)
+		}
+		seen[arm.Variant] = true
+		variants = append(variants, arm.Variant)
+	}
+	if len(variants) == 0 {
+		return
+	}
+	// Перечисление выбирается по всем веткам: одинаковые имена вариантов
+	// могут быть в нескольких перечислениях. Если подходит несколько,
+	// тип значения неизвестен и проверки пропускаются.
+	candidates := c.enumsWith(variants...)
+	if len(candidates) > 1 {
+		return
+	}
+	if len(candidates) == 0 {
+		owners := c.enumsWith(variants[0])
+		if len(owners) == 0 {
+			return
+		}
+		if len(owners) > 1 {
+			c.errorf("Варианты match не принадлежат одному перечислению")
+			return
+		}
+		candidates = owners
+	}
+	enum := candidates[0]
+	for _, arm := range stmt.Arms {
+		if arm.Variant == "_" {
+			continue
+		}
+		variant := findVariant(enum, arm.Variant)
+		if variant == nil {
+			if others := c.enumsWith(arm.Variant); len(others) > 0 {
+				c.errorf("Варианты перечислений %s и %s смешаны в одном match", enum.Name, others[0].Name)
+			} else {
+				c.errorf("Вариант %s не найден в перечислении %s", arm.Variant, enum.Name)
+			}
+			continue
+		}
+		if len(arm.Bindings) > 0 && len(arm.Bindings) != len(variant.Types) {
+			c.errorf("Вариант %s содержит значений: %d, но указано имён: %d",
+				arm.Variant, len(variant.Types), len(arm.Bindings))
+		}
+	}
+	if wildcard {
+		return
+	}
+	for _, variant := range enum.Variants {
+		if !seen[variant.Name] {
+			c.errorf("match не обрабатывает вариант %s перечисления %s", variant.Name, enum.Name)
+		}
+	}
+}
+
+// enumsWith возвращает перечисления этого файла, содержащие все варианты
+// names, в порядке имён.
+func (c *checker) enumsWith(names ...string) []*ast.EnumDecStatement {
+	var found []*ast.EnumDecStatement
+	for _, enum := range c.enums {
+		contains := true
+		for _, name := range names {
+			if findVariant(enum, name) == nil {
+				contains = false
+				break
+			}
+		}
+		if contains {
+			found = append(found, enum)
+		}
+	}
+	sort.Slice(found, func(i, j int) bool {
+		return found[i].Name < found[j].Name
+	})
+	return found
+}
+
+func findVariant(enum *ast.EnumDecStatement, name string) *ast.EnumVariantStatement {
+	for i := range enum.Variants {
+		if enum.Variants[i].Name == name {
+			return &enum.Variants[i]
+		}
+	}
+	return nil
+}
+
+func (c *checker) checkExpression(expr ast.Expression) {
+	switch expr := expr.(type) {
+	case *ast.BOExpression:
+		c.checkExpression(expr.Left)
+		// правая часть and/or вычисляется, только если левая это позволила:
+		// x != null and x.ready
+		outer := c.nullable
+		switch expr.Op.Kind {
+		case lexer.AND:
+			c.nullable = narrowed(outer, nonNullWhen(expr.Left, true))
+		case lexer.OR:
+			c.nullable = narrowed(outer, nonNullWhen(expr.Left, false))
+		}
+		c.checkExpression(expr.Right)
+		c.nullable = outer
+	case *ast.IsExpression:
+		c.checkExpression(expr.Value)
+	case *ast.PrefixExpression:
+		c.checkExpression(expr.RightExpr)
+	case *ast.AssignmentExpression:
+		c.checkExpression(expr.Assigne)
+		c.checkExpression(expr.Value)
+		if key := nullableKey(expr.Assigne); key != "" {
+			c.forget(key)
+			c.nullable[key] = c.mayBeNull(expr.Value)
+		}
+		if symbol, ok := expr.Assigne.(*ast.SymbolExpression); ok {
+			c.zipped[symbol.Value] = c.isZipped(expr.Value)
+		}
+	case *ast.ClassInstance:
+		for _, param := range expr.Parameters {
+			c.checkExpression(param)
+		}
+		for _, field := range expr.Fields {
+			c.checkExpression(field)
+		}
+	case *ast.ArrayInstance:
+		c.checkDereference(expr.Underlying)
+		c.checkExpression(expr.Underlying)
+		for _, e := range expr.Content {
+			c.checkExpression(e)
+		}
+	case *ast.ArrayDeclaration:
+		for _, e := range expr.Elements {
+			c.checkExpression(e)
+		}
+	case *ast.MapDeclaration:
+		for i := range expr.Keys {
+			c.checkExpression(expr.Keys[i])
+			c.checkExpression(expr.Values[i])
+		}
+	case *ast.MemberInstance:
+		if !expr.IsOptional {
+			c.checkDereference(expr.Instance)
+		}
+		c.checkExpression(expr.Instance)
+		if call, ok := expr.MemberName.(*ast.FunctionInstance); ok {
+			// методы и функции модулей неизвестны до запуска,
+			// проверяем только их аргументы
+			c.checkArguments(call)
+		}
+	case *ast.FunctionInstance:
+		c.checkArguments(expr)
+		if fn, ok := c.functions[expr.FunctionName]; ok {
+			c.checkCall(expr, fn)
+		}
+	}
+}
+
+func (c *checker) checkArguments(call *ast.FunctionInstance) {
+	for _, arg := range call.Parameters {
+		c.checkExpression(arg)
+	}
+	for _, arg := range call.NamedParameters {
+		c.checkExpression(arg)
+	}
+}
+
+// checkTypeParameters проверяет параметры типа функции или класса и
+// возвращает все параметры типа, видимые внутри неё.
+func (c *checker) checkTypeParameters(name string, params []string, outer map[string]bool) map[string]bool {
+	visible := make(map[string]bool, len(outer)+len(params))
+	for param := range outer {
+		visible[param] = true
+	}
+	seen := make(map[string]bool, len(params))
+	for _, param := range params {
+		if seen[param] {
+			c.errorf("Параметр типа %s в %s объявлен дважды", param, name)
+		}
+		if outer[param] {
+			c.errorf("Параметр типа %s в %s скрывает параметр типа класса", param, name)
+		}
+		seen[param] = true
+		visible[param] = true
+	}
+	return visible
+}
+
+// checkDereference сообщает об обращении к члену или элементу значения,
+// которое может быть null и не было проверено.
+func (c *checker) checkDereference(expr ast.Expression) {
+	if call, ok := expr.(*ast.FunctionInstance); ok && c.mayBeNull(call) {
+		c.errorf("Результат функции %s может быть null: проверьте его или используйте ?.", call.FunctionName)
+		return
+	}
+	key := nullableKey(expr)
+	if key == "" {
+		return
+	}
+	nullable, tracked := c.nullable[key]
+	if !tracked {
+		nullable = c.nullableField(expr)
+	}
+	if nullable {
+		c.errorf("Значение %s может быть null: проверьте его или используйте ?.", key)
+	}
+}
+
+// nullableField сообщает, что expr — поле, объявленное со знаком ?,
+// у объекта, чей класс известен.
+func (c *checker) nullableField(expr ast.Expression) bool {
+	member, ok := expr.(*ast.MemberInstance)
+	if !ok {
+		return false
+	}
+	field, ok := member.MemberName.(*ast.SymbolExpression)
+	if !ok {
+		return false
+	}
+	fieldType := c.fieldType(c.classOf(member.Instance), field.Value)
+	_, isNullable := fieldType.(*ast.NullableType)
+	return isNullable
+}
+
+// classOf возвращает имя класса значения expr или "", если он неизвестен.
+func (c *checker) classOf(expr ast.Expression) string {
+	switch expr := expr.(type) {
+	case *ast.SymbolExpression:
+		if expr.Value == "this" {
+			return c.thisClass
+		}
+		return c.types[expr.Value]
+	case *ast.MemberInstance:
+		field, ok := expr.MemberName.(*ast.SymbolExpression)
+		if !ok {
+			return ""
+		}
+		return c.className(c.fieldType(c.classOf(expr.Instance), field.Value))
+	}
+	return ""
+}
+
+// fieldType возвращает объявленный тип поля класса или его предков.
+func (c *checker) fieldType(className, name string) ast.Type {
+	for class := c.classes[className]; class != nil; class = c.classes[class.Parent] {
+		if field, ok := class.Fields[name]; ok && !field.IsStatic {
+			return field.Type
+		}
+	}
+	return nil
+}
+
+// className возвращает имя класса, если тип t — класс или класс со знаком ?.
+func (c *checker) className(t ast.Type) string {
+	if nullable, ok := t.(*ast.NullableType); ok {
+		t = nullable.Underlying
+	}
+	if symbol, ok := t.(*ast.SymbolType); ok && c.classes[symbol.Name] != nil {
+		return symbol.Name
+	}
+	return ""
+}
+
+// setType запоминает класс переменной по объявленному типу или по
+// созданию экземпляра: var p P?; var p = ^^P();
+func (c *checker) setType(name string, declared ast.Type, value ast.Expression) {
+	if className := c.className(declared); className != "" {
+		c.types[name] = className
+	} else if instance, ok := value.(*ast.ClassInstance); ok && c.classes[instance.ClassName] != nil {
+		c.types[name] = instance.ClassName
+	}
+}
+
+// forget сбрасывает сведения о значении key и цепочках полей от него:
+// после p = q; проверка p.next != null уже ничего не говорит.
+func (c *checker) forget(key string) {
+	for tracked := range c.nullable {
+		if strings.HasPrefix(tracked, key+".") {
+			delete(c.nullable, tracked)
+		}
+	}
+}
+
+// mayBeNull сообщает, что выражение может дать null: литерал null
+// или вызов функции, возвращающей тип со знаком ?.
+func (c *checker) mayBeNull(expr ast.Expression) bool {
+	switch expr := expr.(type) {
+	case *ast.NullExpression:
+		return true
+	case *ast.FunctionInstance:
+		fn, ok := c.functions[expr.FunctionName]
+		if !ok || len(fn.ReturnType) != 1 {
+			return false
+		}
+		_, isNullable := fn.ReturnType[0].(*ast.NullableType)
+		return isNullable
+	}
+	return false
+}
+
+// nullableKey возвращает имя, под которым отслеживается значение:
+// имя переменной или цепочка полей вида this.next.name.
+func nullableKey(expr ast.Expression) string {
+	switch expr := expr.(type) {
+	case *ast.SymbolExpression:
+		return expr.Value
+	case *ast.MemberInstance:
+		field, isField := expr.MemberName.(*ast.SymbolExpression)
+		if instance := nullableKey(expr.Instance); isField && instance != "" {
+			return instance + "." + field.Value
+		}
+	}
+	return ""
+}
+
+// nonNullWhen возвращает значения, которые точно не равны null, если
+// условие cond истинно (outcome == true) или ложно.
+func nonNullWhen(cond ast.Expression, outcome bool) []string {
+	expr, ok := cond.(*ast.BOExpression)
+	if !ok {
+		return nil
+	}
+	switch expr.Op.Kind {
+	case lexer.NOT_EQUALS, lexer.EQUALS:
+		if (expr.Op.Kind == lexer.NOT_EQUALS) != outcome {
+			return nil
+		}
+		if _, isNull := expr.Right.(*ast.NullExpression); isNull {
+			if key := nullableKey(expr.Left); key != "" {
+				return []string{key}
+			}
+		}
+		if _, isNull := expr.Left.(*ast.NullExpression); isNull {
+			if key := nullableKey(expr.Right); key != "" {
+				return []string{key}
+			}
+		}
+	case lexer.AND:
+		if outcome {
+			return append(nonNullWhen(expr.Left, true), nonNullWhen(expr.Right, true)...)
+		}
+	case lexer.OR:
+		if !outcome {
+			return append(nonNullWhen(expr.Left, false), nonNullWhen(expr.Right, false)...)
+		}
+	}
+	return nil
+}
+
+func narrowed(nullable map[string]bool, checked []string) map[string]bool {
+	out := make(map[string]bool, len(nullable))
+	for key, value := range nullable {
+		out[key] = value
+	}
+	for _, key := range checked {
+		out[key] = false
+	}
+	return out
+}
+
+// terminates сообщает, что блок всегда завершается return или throw.
+func terminates(block *ast.BlockStatement) bool {
+	if block == nil || len(block.Statements) == 0 {
+		return false
+	}
+	switch block.Statements[len(block.Statements)-1].(type) {
+	case *ast.ReturnStatement, *ast.ThrowStatement:
+		return true
+	}
+	return false
+}
+
+func (c *checker) checkFunction(fnName string, typeParams map[string]bool, params []ast.VariableDecStatement, body *ast.BlockStatement) {
+	outer, outerTypes, outerZipped := c.nullable, c.types, c.zipped
+	c.nullable, c.types, c.zipped = make(map[string]bool), make(map[string]string), make(map[string]bool)
+	defer func() { c.nullable, c.types, c.zipped = outer, outerTypes, outerZipped }()
+	for _, param := range params {
+		if _, ok := param.Type.(*ast.NullableType); ok {
+			c.nullable[param.Names[0]] = true
+		}
+		c.setType(param.Names[0], param.Type, nil)
+	}
+	seen := make(map[string]bool)
+	hasDefault := false
+	for i, param := range params {
+		name := param.Names[0]
+		if seen[name] {
+			c.errorf("Параметр %s функции %s объявлен дважды", name, fnName)
+		}
+		seen[name] = true
+		if param.IsVariadic {
+			if i != len(params)-1 {
+				c.errorf("Вариативный параметр %s функции %s должен быть последним", name, fnName)
+			}
+			if param.AssignedValue != nil {
+				c.errorf("Вариативный параметр %s функции %s не может иметь значения по умолчанию", name, fnName)
+			}
+			continue
+		}
+		if param.AssignedValue != nil {
+			hasDefault = true
+			if !literalMatchesType(param.AssignedValue, param.Type, typeParams) {
+				c.errorf("Значение по умолчанию параметра %s функции %s не соответствует типу", name, fnName)
+			}
+			c.checkExpression(param.AssignedValue)
+		} else if hasDefault {
+			c.errorf("Параметр %s функции %s без значения по умолчанию следует за параметром со значением по умолчанию", name, fnName)
+		}
+	}
+	c.checkBlock(body)
+}
+
+func (c *checker) checkCall(call *ast.FunctionInstance, fn *ast.FunctionDecStatement) {
+	params := fn.Parameters
+	fixed := len(params)
+	variadic := fixed > 0 && params[fixed-1].IsVariadic
+	if variadic {
+		fixed--
+	}
+	if len(call.Parameters) > fixed && !variadic {
+		c.errorf("Неверное число аргументов для функции %s. Ожидается %d, но получено %d",
+			fn.Name, len(params), len(call.Parameters))
+	}
+	provided := make(map[string]bool)
+	for i := 0; i < len(call.Parameters) && i < fixed; i++ {
+		provided[params[i].Names[0]] = true
+	}
+	for name := range call.NamedParameters {
+		param := findParameter(params, name)
+		if param == nil {
+			c.errorf("Функция %s не имеет параметра %s", fn.Name, name)
+			continue
+		}
+		if param.IsVariadic {
+			c.errorf("Вариативный параметр %s не может быть передан по имени", name)
+			continue
+		}
+		if provided[name] {
+			c.errorf("Параметр %s функции %s передан дважды", name, fn.Name)
+		}
+		provided[name] = true
+	}
+	for _, param := range params[:fixed] {
+		if !provided[param.Names[0]] && param.AssignedValue == nil {
+			c.errorf("Не передан аргумент %s функции %s", param.Names[0], fn.Name)
+		}
+	}
+}
+
+// checkValueCount сверяет число переменных с числом присваиваемых значений,
+// учитывая, сколько значений возвращают известные функции. Число значений
+// методов и элементов zip, которые хранят кортежи, известно только при выполнении.
+func (c *checker) checkValueCount(names int, values []ast.Expression) {
+	count := 0
+	for _, value := range values {
+		if index, ok := value.(*ast.ArrayInstance); ok && c.isZipped(index.Underlying) {
+			return
+		}
+		if member, ok := value.(*ast.MemberInstance); ok {
+			if _, isCall := member.MemberName.(*ast.FunctionInstance); isCall {
+				return
+			}
+		}
+		call, ok := value.(*ast.FunctionInstance)
+		if !ok {
+			count++
+			continue
+		}
+		fn, ok := c.functions[call.FunctionName]
+		if !ok {
+			return
+		}
+		count += len(fn.ReturnType)
+	}
+	// функция без результата даёт null: var x = g();
+	if count == 0 && names == 1 {
+		return
+	}
+	if count != names {
+		c.errorf("Невозможно присвоить %d значений %d переменным", count, names)
+	}
+}
+
+// isZipped сообщает, что expr — результат zip: zip(a, b) или переменная с ним.
+func (c *checker) isZipped(expr ast.Expression) bool {
+	switch expr := expr.(type) {
+	case *ast.FunctionInstance:
+		_, shadowed := c.functions[expr.FunctionName]
+		return expr.FunctionName == "zip" && !shadowed
+	case *ast.SymbolExpression:
+		return c.zipped[expr.Value]
+	}
+	return false
+}
+
+func findParameter(params []ast.VariableDecStatement, name string) *ast.VariableDecStatement {
+	for i := range params {
+		if params[i].Names[0] == name {
+			return &params[i]
+		}
+	}
+	return nil
+}
+
+// literalMatchesType проверяет литералы; для остальных выражений и
+// параметров типа тип известен только во время выполнения.
+func literalMatchesType(expr ast.Expression, _type ast.Type, typeParams map[string]bool) bool {
+	symbol, ok := _type.(*ast.SymbolType)
+	if !ok || typeParams[symbol.Name] {
+		return true
+	}
+	switch e := expr.(type) {
+	case *ast.NumberExpression:
+		isWhole := e.Value == float64(int64(e.Value))
+		return (symbol.Name == "int" && isWhole) || (symbol.Name == "float" && !isWhole)
+	case *ast.StringExpression:
+		return symbol.Name == "string"
+	case *ast.BooleanExpression:
+		return symbol.Name == "bool"
+	}
+	return true
+}
@@ -3,6 +3,7 @@ package lexer
 import (
 	"fmt"
 	"regexp"
+	"strings"
 )
 
 type regexPattern struct {
@@ -33,10 +34,40 @@ func skipHandler(lex *lexer, regex *regexp.Regexp) {
 func stringHandler(lex *lexer, regex *regexp.Regexp) {
 	match := regex.FindStringIndex(lex.getReminder())
 	stringLiteral := lex.getReminder()[match[0]+1 : match[1]-1]
-	lex.addTokens(NewToken(STRING, stringLiteral))
+	lex.addTokens(NewToken(STRING, unescape(stringLiteral)))
 	lex.advance(len(stringLiteral) + 2)
 }
 
+// unescape заменяет \n, \t, \r, \" и \\ на сами символы; остальные
+// последовательности с обратной косой чертой остаются как есть.
+func unescape(literal string) string {
+	if !strings.Contains(literal, "\\") {
+		return literal
+	}
+	var sb strings.Builder
+	for i := 0; i < len(literal); i++ {
+		if literal[i] != '\\' || i+1 == len(literal) {
+			sb.WriteByte(literal[i])
+			continue
+		}
+		i++
+		switch literal[i] {
+		case 'n':
+			sb.WriteByte('\n')
+		case 't':
+			sb.WriteByte('\t')
+		case 'r':
+			sb.WriteByte('\r')
+		case '"', '\\':
+			sb.WriteByte(literal[i])
+		default:
+			sb.WriteByte('\\')
+			sb.WriteByte(literal[i])
+		}
+	}
+	return sb.String()
+}
+
 func symbolHandler(lex *lexer, regex *regexp.Regexp) {
 	value := regex.FindString(lex.getReminder())
 
@@ -52,13 +83,18 @@ var defaultPatterns = []regexPattern{
 	{regexp.MustCompile(`[a-zA-Z_][a-zA-Z0-9_]*`), symbolHandler},
 	{regexp.MustCompile(`[0-9]+(\.[0-9]+)?`), numberHandler},
 	{regexp.MustCompile(`\s+`), skipHandler},
-	{regexp.MustCompile(`"[^"]*"`), stringHandler},
+	{regexp.MustCompile(`"(?:[^"\\]|\\.)*"`), stringHandler},
 	{regexp.MustCompile(`\#\#.*`), skipHandler},
+	{regexp.MustCompile(`\.\.\.`), defaultHandler(ELLIPSIS, "...")},
 	{regexp.MustCompile(`[.]`), defaultHandler(DOT, ".")},
+	{regexp.MustCompile(`\?\?`), defaultHandler(NULL_COALESCING, "??")},
+	{regexp.MustCompile(`\?\.`), defaultHandler(QUESTION_DOT, "?.")},
+	{regexp.MustCompile(`\?`), defaultHandler(QUESTION, "?")},
 	{regexp.MustCompile(`\(`), defaultHandler(LPAR, "(")},
 	{regexp.MustCompile(`\)`), defaultHandler(RPAR, ")")},
 	{regexp.MustCompile(`\^`), defaultHandler(EXCLAMINATION_MARK, "!")},
 	{regexp.MustCompile(`,`), defaultHandler(COMMA, ",")},
+	{regexp.MustCompile(`:`), defaultHandler(COLON, ":")},
 	{regexp.MustCompile(`;`), defaultHandler(SEMICOLON, ";")},
 	{regexp.MustCompile(`<=`), defaultHandler(LESS_EQUALS, "<=")},
 	{regexp.MustCompile(`>=`), defaultHandler(GREATER_EQUALS, ">=")},
@@ -17,6 +17,10 @@ const (
 
 	// Operators and delimiters
 	DOT
+	ELLIPSIS
+	QUESTION
+	QUESTION_DOT
+	NULL_COALESCING
 	ASSIGN
 	PLUS
 	PLUS_EQUALS
@@ -30,6 +34,7 @@ const (
 	RPAR
 	SEMICOLON
 	COMMA
+	COLON
 	LESS
 	LESS_EQUALS
 	GREATER
@@ -48,6 +53,7 @@ const (
 	FOR
 	TRUE
 	FALSE
+	NULL
 	OR
 	AND
 	IMPORT
@@ -58,6 +64,17 @@ const (
 	STATIC
 	PUBLIC
 	PRIVATE
+	TRY
+	CATCH
+	FINALLY
+	THROW
+	CONSTRUCTOR
+	EXTENDS
+	IS
+	INTERFACE
+	IMPLEMENTS
+	ENUM
+	MATCH
 
 	LCURLY
 	RCURLY
@@ -67,26 +84,38 @@ const (
 )
 
 var reserved_lookup map[string]TokenKind = map[string]TokenKind{
-	"assign":   ASSIGN,
-	"function": FUNCTION,
-	"var":      VAR,
-	"return":   RETURN,
-	"if":       IF,
-	"else":     ELSE,
-	"while":    WHILE,
-	"for":      FOR,
-	"true":     TRUE,
-	"false":    FALSE,
-	"or":       OR,
-	"and":      AND,
-	"import":   IMPORT,
-	"const":    CONST,
-	"class":    CLASS,
-	"static":   STATIC,
-	"public":   PUBLIC,
-	"private":  PRIVATE,
-	"void":     VOID,
-	"!":        EXCLAMINATION_MARK,
+	"assign":      ASSIGN,
+	"function":    FUNCTION,
+	"var":         VAR,
+	"return":      RETURN,
+	"if":          IF,
+	"else":        ELSE,
+	"while":       WHILE,
+	"for":         FOR,
+	"true":        TRUE,
+	"false":       FALSE,
+	"null":        NULL,
+	"or":          OR,
+	"and":         AND,
+	"import":      IMPORT,
+	"const":       CONST,
+	"class":       CLASS,
+	"static":      STATIC,
+	"public":      PUBLIC,
+	"private":     PRIVATE,
+	"try":         TRY,
+	"catch":       CATCH,
+	"finally":     FINALLY,
+	"throw":       THROW,
+	"constructor": CONSTRUCTOR,
+	"extends":     EXTENDS,
+	"is":          IS,
+	"interface":   INTERFACE,
+	"implements":  IMPLEMENTS,
+	"enum":        ENUM,
+	"match":       MATCH,
+	"void":        VOID,
+	"!":           EXCLAMINATION_MARK,
 }
 
 type Token struct {
@@ -142,6 +171,8 @@ func TokenKindString(kind TokenKind) string {
 		return "SEMICOLON"
 	case COMMA:
 		return "COMMA"
+	case COLON:
+		return "COLON"
 	case LESS:
 		return "LESS"
 	case LESS_EQUALS:
@@ -174,6 +205,8 @@ func TokenKindString(kind TokenKind) string {
 		return "TRUE"
 	case FALSE:
 		return "FALSE"
+	case NULL:
+		return "NULL"
 	case OR:
 		return "OR"
 	case AND:
@@ -194,6 +227,28 @@ func TokenKindString(kind TokenKind) string {
 		return "PUBLIC"
 	case PRIVATE:
 		return "PRIVATE"
+	case TRY:
+		return "TRY"
+	case CATCH:
+		return "CATCH"
+	case FINALLY:
+		return "FINALLY"
+	case THROW:
+		return "THROW"
+	case CONSTRUCTOR:
+		return "CONSTRUCTOR"
+	case EXTENDS:
+		return "EXTENDS"
+	case IS:
+		return "IS"
+	case INTERFACE:
+		return "INTERFACE"
+	case IMPLEMENTS:
+		return "IMPLEMENTS"
+	case ENUM:
+		return "ENUM"
+	case MATCH:
+		return "MATCH"
 	case LCURLY:
 		return "LCURLY"
 	case RCURLY:
@@ -204,6 +259,14 @@ func TokenKindString(kind TokenKind) string {
 		return "EXCLAMINATION_MARK"
 	case DOT:
 		return "DOT"
+	case ELLIPSIS:
+		return "ELLIPSIS"
+	case QUESTION:
+		return "QUESTION"
+	case QUESTION_DOT:
+		return "QUESTION_DOT"
+	case NULL_COALESCING:
+		return "NULL_COALESCING"
 	}
 	return "UNKNOWN"
 }
@@ -1,39 +1,29 @@
 package start
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"meow/source/lexer"
+	"meow/source/meow"
 	"meow/source/parser"
-	"meow/source/runner"
-	"meow/source/runner/object"
 	"os"
-	"path/filepath"
 
 	"github.com/sanity-io/litter"
 )
 
-func Start(_filepath string) {
-	fileExtension := filepath.Ext(_filepath)
-	if fileExtension != ".meow" {
-		panic(fmt.Sprintf("Файлы языка имеют расширение .meow, не %s", fileExtension))
+func Start(_filepath string, options ...meow.Option) {
+	interpreter := meow.New(options...)
+	err := interpreter.RunFile(context.Background(), _filepath)
+	var exit *meow.ExitError
+	if errors.As(err, &exit) {
+		os.Exit(exit.Code)
 	}
-	file, err := os.Open(_filepath)
 	if err != nil {
-		panic(err)
-	}
-	defer file.Close()
-	input, err := io.ReadAll(file)
-	if err != nil {
-		panic(err)
-	}
-	tokens := lexer.Tokenize(string(input))
-	ast, err := parser.Parse(tokens)
-	if err != nil {
-		panic(err)
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
 	}
-	env := object.NewEnvironment()
-	runner.ExecuteProgram(ast, env)
 }
 
 func DebugTree(filepath string) {
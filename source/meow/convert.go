@@ -0,0 +1,164 @@
+package meow
+
+import (
+	"fmt"
+	"meow/source/runner"
+	"meow/source/runner/object"
+	"reflect"
+	"sort"
+)
+
+// ToObject превращает значение Go в значение языка. Поддерживаются nil,
+// числа, строки, bool, срезы, словари и функции object.BuiltinFunction.
+func ToObject(value any) (object.Object, error) {
+	switch v := value.(type) {
+	case nil:
+		return runner.NULL, nil
+	case object.Object:
+		return v, nil
+	case bool:
+		if v {
+			return runner.TRUE, nil
+		}
+		return runner.FALSE, nil
+	case string:
+		return &object.String{Value: []rune(v)}, nil
+	case object.BuiltinFunction:
+		return &object.Builtin{Name: "native", Arity: -1, Fn: v}, nil
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &object.Integer{Value: rv.Int()}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &object.Integer{Value: int64(rv.Uint())}, nil
+	case reflect.Float32, reflect.Float64:
+		return &object.Float{Value: rv.Float()}, nil
+	case reflect.Slice, reflect.Array:
+		return sliceToObject(rv)
+	case reflect.Map:
+		return mapToObject(rv)
+	}
+	return nil, fmt.Errorf("Тип %T нельзя передать в программу", value)
+}
+
+func sliceToObject(rv reflect.Value) (object.Object, error) {
+	array := &object.Array{Elements: make([]object.Object, 0, rv.Len())}
+	for i := 0; i < rv.Len(); i++ {
+		elem, err := ToObject(rv.Index(i).Interface())
+		if err != nil {
+			return nil, err
+		}
+		if array.ElementsType == "" {
+			array.ElementsType = elem.Type()
+		}
+		if elem.Type() != array.ElementsType {
+			return nil, fmt.Errorf("Все элементы массива должны быть одного типа")
+		}
+		array.Elements = append(array.Elements, elem)
+	}
+	return array, nil
+}
+
+func mapToObject(rv reflect.Value) (object.Object, error) {
+	m := &object.Map{Values: map[object.MapKey]object.Object{}}
+	keys := rv.MapKeys()
+	// Порядок обхода словаря в Go случаен, а словарь языка упорядочен.
+	sort.Slice(keys, func(a, b int) bool {
+		return fmt.Sprint(keys[a].Interface()) < fmt.Sprint(keys[b].Interface())
+	})
+	for _, k := range keys {
+		key, err := ToObject(k.Interface())
+		if err != nil {
+			return nil, err
+		}
+		value, err := ToObject(rv.MapIndex(k).Interface())
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := object.KeyOf(key); !ok {
+			return nil, fmt.Errorf("Значение типа %s не может быть ключом словаря", key.Type())
+		}
+		if m.KeyType == "" {
+			m.KeyType, m.ValueType = key.Type(), value.Type()
+		}
+		if key.Type() != m.KeyType || value.Type() != m.ValueType {
+			return nil, fmt.Errorf("Все ключи и все значения словаря должны быть одного типа")
+		}
+		m.Set(key, value)
+	}
+	return m, nil
+}
+
+// FromObject превращает значение языка в значение Go: int64, float64,
+// string, bool, nil, []any, map[any]any, а экземпляр класса — в
+// map[string]any его полей.
+func FromObject(obj object.Object) (any, error) {
+	switch obj := obj.(type) {
+	case *object.Integer:
+		return obj.Value, nil
+	case *object.Float:
+		return obj.Value, nil
+	case *object.String:
+		return string(obj.Value), nil
+	case *object.Boolean:
+		return obj.Value, nil
+	case *object.Null:
+		return nil, nil
+	case *object.Array:
+		return elementsFromObject(obj.Elements)
+	case *object.Tuple:
+		return elementsFromObject(obj.Elements)
+	case *object.Map:
+		result := make(map[any]any, len(obj.Keys))
+		for _, key := range obj.Keys {
+			value, _ := obj.Get(key)
+			k, err := FromObject(key)
+			if err != nil {
+				return nil, err
+			}
+			v, err := FromObject(value)
+			if err != nil {
+				return nil, err
+			}
+			result[k] = v
+		}
+		return result, nil
+	case *object.Class:
+		if obj.Class == nil {
+			break
+		}
+		result := make(map[string]any, len(obj.Fields))
+		for name, field := range obj.Fields {
+			value, err := FromObject(field)
+			if err != nil {
+				return nil, err
+			}
+			result[name] = value
+		}
+		return result, nil
+	}
+	return nil, &ConversionError{Type: obj.Type()}
+}
+
+// ConversionError возвращается, когда значение языка нельзя передать в Go:
+// например, функцию или класс.
+type ConversionError struct {
+	Type object.ObjectType
+}
+
+func (e *ConversionError) Error() string {
+	return fmt.Sprintf("Значение типа %s нельзя передать в Go", e.Type)
+}
+
+func elementsFromObject(elements []object.Object) ([]any, error) {
+	result := make([]any, 0, len(elements))
+	for _, elem := range elements {
+		value, err := FromObject(elem)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, value)
+	}
+	return result, nil
+}
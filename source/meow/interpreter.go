@@ -0,0 +1,250 @@
+// Package meow позволяет встраивать язык в программы на Go.
+package meow
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"meow/source/ast"
+	"meow/source/checker"
+	"meow/source/runner"
+	"meow/source/runner/object"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Interpreter хранит глобальное окружение: переменные и функции,
+// объявленные в одном вызове Eval, доступны в следующих.
+type Interpreter struct {
+	env     *object.Environment
+	runtime *object.Runtime
+}
+
+type Option func(*Interpreter)
+
+// WithStdin задаёт, откуда программа читает ввод.
+func WithStdin(r io.Reader) Option {
+	return func(i *Interpreter) {
+		i.runtime.Stdin = r
+	}
+}
+
+// WithStdout направляет вывод программы в w.
+func WithStdout(w io.Writer) Option {
+	return func(i *Interpreter) {
+		i.runtime.Stdout = w
+	}
+}
+
+// WithStderr направляет вывод ошибок программы в w.
+func WithStderr(w io.Writer) Option {
+	return func(i *Interpreter) {
+		i.runtime.Stderr = w
+	}
+}
+
+// WithArgs передаёт программе аргументы командной строки, доступные
+// как os.args.
+func WithArgs(args []string) Option {
+	return func(i *Interpreter) {
+		i.runtime.Args = args
+	}
+}
+
+// WithLimits ограничивает каждый запуск Eval, RunFile и Call.
+func WithLimits(limits object.Limits) Option {
+	return func(i *Interpreter) {
+		i.runtime.Limits = limits
+	}
+}
+
+// WithCapabilities задаёт, к чему программа имеет доступ на хосте.
+func WithCapabilities(capabilities object.Capabilities) Option {
+	return func(i *Interpreter) {
+		i.runtime.Capabilities = capabilities
+	}
+}
+
+func New(options ...Option) *Interpreter {
+	env := object.NewEnvironment()
+	interpreter := &Interpreter{env: env, runtime: env.Runtime()}
+	for _, option := range options {
+		option(interpreter)
+	}
+	return interpreter
+}
+
+// Error — ошибка выполнения программы вместе со стеком вызовов.
+// Fatal означает, что программа прервана из-за лимитов или отмены
+// контекста.
+type Error struct {
+	Message string
+	Stack   []string
+	Fatal   bool
+}
+
+func (e *Error) Error() string {
+	var sb strings.Builder
+	sb.WriteString(e.Message)
+	for i := 0; i < len(e.Stack); {
+		// Подряд идущие одинаковые кадры глубокой рекурсии выводятся одной строкой.
+		repeats := 1
+		for i+repeats < len(e.Stack) && e.Stack[i+repeats] == e.Stack[i] {
+			repeats++
+		}
+		sb.WriteString("\n\tв ")
+		sb.WriteString(e.Stack[i])
+		if repeats > 1 {
+			fmt.Fprintf(&sb, " (%d раз)", repeats)
+		}
+		i += repeats
+	}
+	return sb.String()
+}
+
+// Eval выполняет исходный код и возвращает значение последней инструкции.
+// Если это объявление функции, класса, интерфейса, перечисления или
+// импорт, возвращается nil. Если значение нельзя передать в Go,
+// возвращается *ConversionError.
+func (i *Interpreter) Eval(ctx context.Context, src string) (any, error) {
+	result, err := i.run(ctx, src)
+	if err != nil || result == nil {
+		return nil, err
+	}
+	return FromObject(result)
+}
+
+// RunFile выполняет файл с расширением .meow.
+func (i *Interpreter) RunFile(ctx context.Context, path string) error {
+	if ext := filepath.Ext(path); ext != ".meow" {
+		return fmt.Errorf("Файлы языка имеют расширение .meow, не %s", ext)
+	}
+	input, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	_, err = i.run(ctx, string(input))
+	return err
+}
+
+func (i *Interpreter) run(ctx context.Context, src string) (object.Object, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	program, err := runner.Parse(src)
+	if err != nil {
+		return nil, err
+	}
+	if err := checker.Check(program); err != nil {
+		return nil, err
+	}
+	i.runtime.Begin(ctx)
+	result := execute(func() object.Object {
+		return runner.ExecuteProgram(program, i.env)
+	})
+	if returned, ok := result.(*object.ReturnValue); ok {
+		result = &object.Tuple{Elements: returned.Values}
+		if len(returned.Values) == 1 {
+			result = returned.Values[0]
+		}
+	}
+	if err := toError(result); err != nil {
+		return nil, err
+	}
+	if n := len(program.Statements); n > 0 && isDeclaration(program.Statements[n-1]) {
+		return nil, nil
+	}
+	return result, nil
+}
+
+// isDeclaration сообщает, что инструкция объявляет имя, а не вычисляет значение.
+func isDeclaration(stmt ast.Statement) bool {
+	if visibility, ok := stmt.(*ast.VisibilityStatement); ok {
+		stmt = visibility.Statement
+	}
+	switch stmt.(type) {
+	case *ast.FunctionDecStatement, *ast.ClassDecStatement, *ast.InterfaceDecStatement,
+		*ast.EnumDecStatement, *ast.ImportStatement:
+		return true
+	}
+	return false
+}
+
+// Call вызывает функцию программы или встроенную функцию по имени.
+func (i *Interpreter) Call(name string, args ...any) (any, error) {
+	fn, ok := i.env.Get(name)
+	if !ok {
+		builtin, exists := runner.LookupBuiltin(name)
+		if !exists {
+			return nil, fmt.Errorf("Неизвестная функция: %s", name)
+		}
+		fn = builtin
+	}
+	values := make([]object.Object, 0, len(args))
+	for _, arg := range args {
+		value, err := ToObject(arg)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	i.runtime.Begin(context.Background())
+	result := execute(func() object.Object {
+		return runner.CallFunction(fn, values, i.env)
+	})
+	if err := toError(result); err != nil {
+		return nil, err
+	}
+	return FromObject(result)
+}
+
+// SetGlobal объявляет глобальную переменную программы.
+func (i *Interpreter) SetGlobal(name string, value any) error {
+	obj, err := ToObject(value)
+	if err != nil {
+		return err
+	}
+	i.env.Set(name, obj)
+	return nil
+}
+
+// GetGlobal возвращает значение глобальной переменной программы.
+func (i *Interpreter) GetGlobal(name string) (any, error) {
+	obj, ok := i.env.Get(name)
+	if !ok {
+		return nil, fmt.Errorf("Неизвестная переменная: %s", name)
+	}
+	return FromObject(obj)
+}
+
+// execute выполняет fn, превращая панику интерпретатора в ошибку
+// выполнения, чтобы она не завершила встраивающую программу.
+func execute(fn func() object.Object) (result object.Object) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = &object.Error{Message: fmt.Sprintf("Внутренняя ошибка интерпретатора: %v", r)}
+		}
+	}()
+	return fn()
+}
+
+// ExitError возвращается, когда программа вызвала exit(code).
+type ExitError struct {
+	Code int
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("Программа завершилась с кодом %d", e.Code)
+}
+
+func toError(result object.Object) error {
+	err, ok := result.(*object.Error)
+	if !ok {
+		return nil
+	}
+	if err.Exit {
+		return &ExitError{Code: int(err.ExitCode)}
+	}
+	return &Error{Message: err.Message, Stack: err.Stack, Fatal: err.Fatal}
+}
@@ -0,0 +1,107 @@
+package meow
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"meow/source/runner/object"
+)
+
+func TestCallDepthLimitedWithoutMaxCallDepth(t *testing.T) {
+	interpreter := New(WithLimits(object.Limits{Timeout: time.Minute}))
+	_, err := interpreter.Eval(context.Background(), "void f(n int) (int) ( return f(n + 1); ); f(0);")
+	if err == nil || !strings.Contains(err.Error(), "Превышена глубина вызовов") {
+		t.Fatalf("ожидалась ошибка глубины вызовов, получено %v", err)
+	}
+}
+
+func TestImportWithLexerErrorReturnsError(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "bad.meow")
+	if err := os.WriteFile(path, []byte("var x = @;"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	interpreter := New()
+	_, err := interpreter.Eval(context.Background(), `import b "`+path+`";`)
+	if err == nil || !strings.Contains(err.Error(), "Ошибка при парсинге файла") {
+		t.Fatalf("ожидалась ошибка парсинга модуля, получено %v", err)
+	}
+}
+
+func TestEvalEndingWithDeclarationReturnsNil(t *testing.T) {
+	interpreter := New()
+	value, err := interpreter.Eval(context.Background(), "void f(n int) (int) ( return n; );")
+	if err != nil || value != nil {
+		t.Fatalf("ожидалось nil, nil, получено %v, %v", value, err)
+	}
+}
+
+func TestEvalReportsUnconvertibleValue(t *testing.T) {
+	interpreter := New()
+	_, err := interpreter.Eval(context.Background(), "void f(n int) (int) ( return n; ); f;")
+	var conversion *ConversionError
+	if !errors.As(err, &conversion) || conversion.Type != object.FUNCTION {
+		t.Fatalf("ожидалась ConversionError, получено %v", err)
+	}
+}
+
+func TestRangeRespectsLimits(t *testing.T) {
+	interpreter := New(WithLimits(object.Limits{MaxSteps: 1000, MaxAllocations: 100, Timeout: time.Second}))
+	start := time.Now()
+	_, err := interpreter.Eval(context.Background(), "var xs = range(50000000);")
+	if err == nil {
+		t.Fatal("ожидалась ошибка лимита")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("range выполнялся %s", elapsed)
+	}
+}
+
+func TestInstancesWithoutConstructorCountAsAllocations(t *testing.T) {
+	interpreter := New(WithLimits(object.Limits{MaxAllocations: 10}))
+	src := `class P ( x int, );
+var i = 0;
+for (i < 1000) (
+    var p = ^^P(x = 1);
+    i = i + 1;
+);`
+	_, err := interpreter.Eval(context.Background(), src)
+	if err == nil || !strings.Contains(err.Error(), "Превышено число созданных объектов") {
+		t.Fatalf("ожидалась ошибка лимита выделений, получено %v", err)
+	}
+}
+
+func TestSandboxDeniesImportOutsideRoot(t *testing.T) {
+	root := t.TempDir()
+	outside := filepath.Join(t.TempDir(), "lib.meow")
+	if err := os.WriteFile(outside, []byte("var x = 1;"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	interpreter := New(WithCapabilities(object.Sandbox(root)))
+	_, err := interpreter.Eval(context.Background(), `import lib "`+outside+`";`)
+	if err == nil || !strings.Contains(err.Error(), "Доступ запрещён") {
+		t.Fatalf("ожидался запрет импорта, получено %v", err)
+	}
+	_, err = interpreter.Eval(context.Background(), `import lib "`+filepath.Join(root, "..", filepath.Base(filepath.Dir(outside)), "lib.meow")+`";`)
+	if err == nil || !strings.Contains(err.Error(), "Доступ запрещён") {
+		t.Fatalf("ожидался запрет импорта через .., получено %v", err)
+	}
+}
+
+func TestSandboxDeniesClockAndProcesses(t *testing.T) {
+	interpreter := New(WithCapabilities(object.Sandbox()))
+	for _, src := range []string{
+		`import time "time"; time.now();`,
+		`import os "os"; os.run("true");`,
+		`import os "os"; os.cwd();`,
+	} {
+		if _, err := interpreter.Eval(context.Background(), src); err == nil || !strings.Contains(err.Error(), "Доступ запрещён") {
+			t.Fatalf("%s: ожидался запрет, получено %v", src, err)
+		}
+	}
+}
@@ -44,6 +44,9 @@ func parsePrimaryExpressions(p *parser) ast.Expression {
     case lexer.FALSE:
 		p.advance()
 		return &ast.BooleanExpression{Value: false}
+	case lexer.NULL:
+		p.advance()
+		return &ast.NullExpression{}
 	default:
 		p.errors = append(p.errors, errors.New("невозможно создать первичное выражение"))
 		return nil
@@ -60,6 +63,14 @@ func parseBinaryExpressions(p *parser, left ast.Expression, bp binding_power) as
 	}
 }
 
+func parseIsExpression(p *parser, left ast.Expression, bp binding_power) ast.Expression {
+	p.expect(lexer.IS)
+	return &ast.IsExpression{
+		Value: left,
+		Type:  parseType(p, bp),
+	}
+}
+
 func parseAssignmentExpressions(p *parser, left ast.Expression, bp binding_power) ast.Expression {
 	operatorToken := p.advance()
 	rhs := parseExpression(p, bp)
@@ -90,15 +101,25 @@ func parseClassInstanceExpressions(p *parser) ast.Expression {
 	p.expect(lexer.EXCLAMINATION_MARK)
 	p.expect(lexer.EXCLAMINATION_MARK)
 	var structName = p.expect(lexer.IDENT).Value
+	var typeArguments = parseTypeArguments(p)
+	var parameters = []ast.Expression{}
 	var fiels = map[string]ast.Expression{}
 	p.expect(lexer.LPAR)
 
 	for p.hasTokens() && p.getCurrToken().Kind != lexer.RPAR {
-		fieldName := p.expect(lexer.IDENT).Value
-		p.expect(lexer.ASSIGN)
-		expr := parseExpression(p, LOGICAL)
-
-		fiels[fieldName] = expr
+		if p.getCurrToken().Kind == lexer.IDENT && p.peek(1) == lexer.ASSIGN {
+			fieldName := p.advance().Value
+			p.expect(lexer.ASSIGN)
+			if _, exists := fiels[fieldName]; exists {
+				panic(fmt.Sprintf("Поле %s объекта %s указано дважды", fieldName, structName))
+			}
+			fiels[fieldName] = parseExpression(p, LOGICAL)
+		} else {
+			if len(fiels) > 0 {
+				panic(fmt.Sprintf("Позиционный аргумент после именованного при создании объекта %s", structName))
+			}
+			parameters = append(parameters, parseExpression(p, LOGICAL))
+		}
 
 		if p.getCurrToken().Kind != lexer.RPAR {
 			p.expect(lexer.COMMA)
@@ -107,8 +128,10 @@ func parseClassInstanceExpressions(p *parser) ast.Expression {
 
 	p.expect(lexer.RPAR)
 	return &ast.ClassInstance{
-		ClassName: structName,
-		Fields:    fiels,
+		ClassName:     structName,
+		TypeArguments: typeArguments,
+		Parameters:    parameters,
+		Fields:        fiels,
 	}
 }
 
@@ -141,10 +164,23 @@ func parseFunctionInstanceExpression(p *parser, left ast.Expression, bp binding_
 
 	}
 	var parameters = []ast.Expression{}
+	var namedParameters = map[string]ast.Expression{}
 	p.expect(lexer.LPAR)
 
 	for p.hasTokens() && p.getCurrToken().Kind != lexer.RPAR {
-		parameters = append(parameters, parseExpression(p, LOGICAL))
+		if p.getCurrToken().Kind == lexer.IDENT && p.peek(1) == lexer.ASSIGN {
+			paramName := p.advance().Value
+			p.expect(lexer.ASSIGN)
+			if _, exists := namedParameters[paramName]; exists {
+				panic(fmt.Sprintf("Аргумент %s функции %s указан дважды", paramName, functionName))
+			}
+			namedParameters[paramName] = parseExpression(p, LOGICAL)
+		} else {
+			if len(namedParameters) > 0 {
+				panic(fmt.Sprintf("Позиционный аргумент после именованного в вызове функции %s", functionName))
+			}
+			parameters = append(parameters, parseExpression(p, LOGICAL))
+		}
 		if p.getCurrToken().Kind != lexer.RPAR {
 			p.expect(lexer.COMMA)
 		}
@@ -152,17 +188,19 @@ func parseFunctionInstanceExpression(p *parser, left ast.Expression, bp binding_
 
 	p.expect(lexer.RPAR)
 	return &ast.FunctionInstance{
-		FunctionName: functionName,
-		Parameters:   parameters,
+		FunctionName:    functionName,
+		Parameters:      parameters,
+		NamedParameters: namedParameters,
 	}
 }
 
 func parseMemberInstanceExpression(p *parser, left ast.Expression, bp binding_power) ast.Expression {
-	p.expect(lexer.DOT)
+	isOptional := p.advance().Kind == lexer.QUESTION_DOT
 	memberName := parseExpression(p, bp)
 	return &ast.MemberInstance{
 		Instance:   left,
 		MemberName: memberName,
+		IsOptional: isOptional,
 	}
 }
 
@@ -181,3 +219,21 @@ func parseArrayDecExpression(p *parser) ast.Expression {
 		Length:   len(elements),
 	}
 }
+
+func parseMapDecExpression(p *parser) ast.Expression {
+	p.expect(lexer.LCURLY)
+	var keys, values []ast.Expression
+	for p.hasTokens() && p.getCurrToken().Kind != lexer.RCURLY {
+		keys = append(keys, parseExpression(p, LOGICAL))
+		p.expect(lexer.COLON)
+		values = append(values, parseExpression(p, LOGICAL))
+		if p.getCurrToken().Kind != lexer.RCURLY {
+			p.expect(lexer.COMMA)
+		}
+	}
+	p.expect(lexer.RCURLY)
+	return &ast.MapDeclaration{
+		Keys:   keys,
+		Values: values,
+	}
+}
@@ -12,6 +12,7 @@ const (
 	COMMA
 	ASSIGN
 	LOGICAL
+	COALESCING
 	RELATIONAL
 	ADDITIVE
 	MULTIPLICATIVE
@@ -60,12 +61,15 @@ func createTokenLookups() {
 	led(lexer.AND, LOGICAL, parseBinaryExpressions)
 	led(lexer.OR, LOGICAL, parseBinaryExpressions)
 
+	led(lexer.NULL_COALESCING, COALESCING, parseBinaryExpressions)
+
 	led(lexer.LESS_EQUALS, RELATIONAL, parseBinaryExpressions)
 	led(lexer.GREATER_EQUALS, RELATIONAL, parseBinaryExpressions)
 	led(lexer.LESS, RELATIONAL, parseBinaryExpressions)
 	led(lexer.GREATER, RELATIONAL, parseBinaryExpressions)
 	led(lexer.EQUALS, RELATIONAL, parseBinaryExpressions)
 	led(lexer.NOT_EQUALS, RELATIONAL, parseBinaryExpressions)
+	led(lexer.IS, RELATIONAL, parseIsExpression)
 
 	led(lexer.PLUS, ADDITIVE, parseBinaryExpressions)
 	led(lexer.MINUS, ADDITIVE, parseBinaryExpressions)
@@ -78,19 +82,29 @@ func createTokenLookups() {
 	nud(lexer.LPAR, parseGroupingExpressions)
 	nud(lexer.TRUE, parsePrimaryExpressions)
 	nud(lexer.FALSE, parsePrimaryExpressions)
+	nud(lexer.NULL, parsePrimaryExpressions)
 
 	led(lexer.LBRAK, CALL, parseArrayInstanceExpressions)
 	nud(lexer.LBRAK, parseArrayDecExpression)
+	nud(lexer.LCURLY, parseMapDecExpression)
 	nud(lexer.EXCLAMINATION_MARK, parseClassInstanceExpressions)
 	led(lexer.LPAR, CALL, parseFunctionInstanceExpression)
 	led(lexer.DOT, MEMBER, parseMemberInstanceExpression)
+	led(lexer.QUESTION_DOT, MEMBER, parseMemberInstanceExpression)
 
 	statement(lexer.CONST, parseVariableDeclaration)
 	statement(lexer.VAR, parseVariableDeclaration)
 	statement(lexer.CLASS, parseClassDeclaration)
+	statement(lexer.INTERFACE, parseInterfaceDeclaration)
+	statement(lexer.ENUM, parseEnumDeclaration)
+	statement(lexer.MATCH, parseMatchStatement)
 	statement(lexer.VOID, parseFunctionDeclaration)
 	statement(lexer.RETURN, parseReturnStatement)
 	statement(lexer.IF, parseIfStatement)
 	statement(lexer.FOR, parseWhileStatement)
 	statement(lexer.IMPORT, parseImportStatement)
+	statement(lexer.PUBLIC, parseVisibilityStatement)
+	statement(lexer.PRIVATE, parseVisibilityStatement)
+	statement(lexer.THROW, parseThrowStatement)
+	statement(lexer.TRY, parseTryStatement)
 }
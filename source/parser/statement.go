@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"fmt"
 	"meow/source/ast"
 	"meow/source/lexer"
 )
@@ -11,6 +12,9 @@ func parseStatement(p *parser) ast.Statement {
 		return statement_func(p)
 	}
 	expression := parseExpression(p, default_power)
+	if p.getCurrToken().Kind == lexer.COMMA {
+		return parseMultiAssignment(p, expression)
+	}
 	p.expect(lexer.SEMICOLON)
 
 	return &ast.ExpressionStatement{
@@ -18,6 +22,27 @@ func parseStatement(p *parser) ast.Statement {
 	}
 }
 
+func parseMultiAssignment(p *parser, first ast.Expression) ast.Statement {
+	assignees := []ast.Expression{first}
+	for p.hasTokens() && p.getCurrToken().Kind == lexer.COMMA {
+		p.advance()
+		assignees = append(assignees, parseExpression(p, ASSIGN))
+	}
+	p.expect(lexer.ASSIGN)
+	var values []ast.Expression
+	for p.hasTokens() && p.getCurrToken().Kind != lexer.SEMICOLON {
+		values = append(values, parseExpression(p, ASSIGN))
+		if p.getCurrToken().Kind != lexer.SEMICOLON {
+			p.expect(lexer.COMMA)
+		}
+	}
+	p.expect(lexer.SEMICOLON)
+	return &ast.MultiAssignmentStatement{
+		Assignees: assignees,
+		Values:    values,
+	}
+}
+
 func parseVariableDeclaration(p *parser) ast.Statement {
 	var expilitType ast.Type
 	var assigmentValue ast.Expression
@@ -61,80 +86,252 @@ func parseClassDeclaration(p *parser) ast.Statement {
 	p.expect(lexer.CLASS)
 	var fields = map[string]ast.ClassFieldStatement{}
 	var functions = map[string]ast.ClassFunctionStatement{}
+	var constructor *ast.ClassFunctionStatement
 	className := p.expect(lexer.IDENT).Value
+	typeParameters := parseTypeParameters(p)
+	var parent string
+	if p.getCurrToken().Kind == lexer.EXTENDS {
+		p.advance()
+		parent = p.expect(lexer.IDENT).Value
+	}
+	var interfaces []string
+	if p.getCurrToken().Kind == lexer.IMPLEMENTS {
+		p.advance()
+		interfaces = append(interfaces, p.expect(lexer.IDENT).Value)
+		for p.getCurrToken().Kind == lexer.COMMA {
+			p.advance()
+			interfaces = append(interfaces, p.expect(lexer.IDENT).Value)
+		}
+	}
 
 	p.expect(lexer.LPAR)
 	for p.hasTokens() && p.getCurrToken().Kind != lexer.RPAR {
 		var isStatic bool
-		var fieldName string
+		var isPrivate bool
+		if p.getCurrToken().Kind == lexer.PRIVATE {
+			isPrivate = true
+			p.advance()
+		} else if p.getCurrToken().Kind == lexer.PUBLIC {
+			p.advance()
+		}
 		if p.getCurrToken().Kind == lexer.STATIC {
 			isStatic = true
 			p.expect(lexer.STATIC)
 		}
-		if p.getCurrToken().Kind == lexer.IDENT {
-			fieldName = p.expect(lexer.IDENT).Value
+		switch p.getCurrToken().Kind {
+		case lexer.CONSTRUCTOR:
+			p.advance()
+			if isStatic || isPrivate {
+				panic("!! Конструктор не может быть статическим или приватным")
+			}
+			if constructor != nil {
+				panic("!! Конструктор уже был указан в классе")
+			}
+			constructor = &ast.ClassFunctionStatement{
+				Parameters: parseParameters(p),
+				Body:       parseBlock(p),
+			}
+		case lexer.VOID:
+			p.advance()
+			functionName := p.expect(lexer.IDENT).Value
+			if _, exists := functions[functionName]; exists {
+				panic("!! Данный метод уже был указан в классе")
+			}
+			functions[functionName] = ast.ClassFunctionStatement{
+				TypeParameters: parseTypeParameters(p),
+				Parameters:     parseParameters(p),
+				ReturnTypes:    parseReturnTypes(p),
+				IsStatic:       isStatic,
+				IsPrivate:      isPrivate,
+				Body:           parseBlock(p),
+			}
+		default:
+			fieldName := p.expect(lexer.IDENT).Value
 			if p.getCurrToken().Kind == lexer.LPAR {
-				p.advance() // eat '('
-				parameters := make([]ast.Type, 0)
-				for p.hasTokens() && p.getCurrToken().Kind != lexer.RPAR {
-					parameterType := parseType(p, PRIMARY)
-					parameters = append(parameters, parameterType)
-				}
-				p.expect(lexer.RPAR)
-				var returnValues []ast.Type
-				if p.getCurrToken().Kind == lexer.LPAR {
-					p.advance()
-					for p.hasTokens() && p.getCurrToken().Kind != lexer.RPAR {
-						returnValueType := parseType(p, PRIMARY)
-						returnValues = append(returnValues, returnValueType)
-						if p.getCurrToken().Kind != lexer.RPAR {
-							p.expect(lexer.COMMA)
-						}
-					}
-				}
-				p.expect(lexer.RPAR)
-				functionName := fieldName
-				functions[functionName] = ast.ClassFunctionStatement{
-					Parameters:  parameters,
-					IsStatic:    isStatic,
-					ReturnTypes: returnValues,
-				}
-			} else {
-				fieldType := parseType(p, default_power)
-				_, exists := fields[fieldName]
-				if exists {
-					panic("!! Данное поле уже было указано в классе")
-				}
-				fields[fieldName] = ast.ClassFieldStatement{
-					Type:     fieldType,
-					IsStatic: isStatic,
+				panic(fmt.Sprintf("!! Метод %s должен быть объявлен в классе через void вместе с телом", fieldName))
+			}
+			fieldType := parseType(p, default_power)
+			var defaultValue ast.Expression
+			if p.getCurrToken().Kind == lexer.ASSIGN {
+				p.advance()
+				defaultValue = parseExpression(p, LOGICAL)
+			}
+			_, exists := fields[fieldName]
+			if exists {
+				panic("!! Данное поле уже было указано в классе")
+			}
+			fields[fieldName] = ast.ClassFieldStatement{
+				Type:      fieldType,
+				IsStatic:  isStatic,
+				IsPrivate: isPrivate,
+				Default:   defaultValue,
+			}
+		}
+		p.expect(lexer.COMMA)
+	}
+	p.expect(lexer.RPAR)
+	p.expect(lexer.SEMICOLON)
+
+	return &ast.ClassDecStatement{
+		Name:           className,
+		TypeParameters: typeParameters,
+		Parent:         parent,
+		Interfaces:     interfaces,
+		Fields:         fields,
+		Functions:      functions,
+		Constructor:    constructor,
+	}
+}
+
+func parseInterfaceDeclaration(p *parser) ast.Statement {
+	p.expect(lexer.INTERFACE)
+	var functions = map[string]ast.ClassFunctionStatement{}
+	interfaceName := p.expect(lexer.IDENT).Value
+
+	p.expect(lexer.LPAR)
+	for p.hasTokens() && p.getCurrToken().Kind != lexer.RPAR {
+		p.expect(lexer.VOID)
+		functionName := p.expect(lexer.IDENT).Value
+		if _, exists := functions[functionName]; exists {
+			panic("!! Данный метод уже был указан в интерфейсе")
+		}
+		functions[functionName] = ast.ClassFunctionStatement{
+			Parameters:  parseParameters(p),
+			ReturnTypes: parseReturnTypes(p),
+		}
+		p.expect(lexer.COMMA)
+	}
+	p.expect(lexer.RPAR)
+	p.expect(lexer.SEMICOLON)
+
+	return &ast.InterfaceDecStatement{
+		Name:      interfaceName,
+		Functions: functions,
+	}
+}
+
+func parseEnumDeclaration(p *parser) ast.Statement {
+	p.expect(lexer.ENUM)
+	enumName := p.expect(lexer.IDENT).Value
+	var variants []ast.EnumVariantStatement
+	p.expect(lexer.LPAR)
+	for p.hasTokens() && p.getCurrToken().Kind != lexer.RPAR {
+		variant := ast.EnumVariantStatement{
+			Name: p.expect(lexer.IDENT).Value,
+		}
+		for _, v := range variants {
+			if v.Name == variant.Name {
+				panic(fmt.Sprintf("!! Вариант %s уже был указан в перечислении %s", variant.Name, enumName))
+			}
+		}
+		if p.getCurrToken().Kind == lexer.LPAR {
+			p.advance()
+			for p.hasTokens() && p.getCurrToken().Kind != lexer.RPAR {
+				variant.Types = append(variant.Types, parseType(p, default_power))
+				if p.getCurrToken().Kind != lexer.RPAR {
+					p.expect(lexer.COMMA)
 				}
 			}
+			p.expect(lexer.RPAR)
+		}
+		variants = append(variants, variant)
+		if p.getCurrToken().Kind != lexer.RPAR {
 			p.expect(lexer.COMMA)
+		}
+	}
+	p.expect(lexer.RPAR)
+	p.expect(lexer.SEMICOLON)
+	return &ast.EnumDecStatement{
+		Name:     enumName,
+		Variants: variants,
+	}
+}
 
-			continue
+func parseMatchStatement(p *parser) ast.Statement {
+	p.expect(lexer.MATCH)
+	p.expect(lexer.LPAR)
+	value := parseExpression(p, default_power)
+	p.expect(lexer.RPAR)
+	var arms []ast.MatchArm
+	p.expect(lexer.LPAR)
+	for p.hasTokens() && p.getCurrToken().Kind != lexer.RPAR {
+		arm := ast.MatchArm{
+			Variant: p.expect(lexer.IDENT).Value,
+		}
+		if p.isBindingList() {
+			p.expect(lexer.LPAR)
+			for p.getCurrToken().Kind != lexer.RPAR {
+				arm.Bindings = append(arm.Bindings, p.expect(lexer.IDENT).Value)
+				if p.getCurrToken().Kind != lexer.RPAR {
+					p.expect(lexer.COMMA)
+				}
+			}
+			p.expect(lexer.RPAR)
 		}
+		arm.Body = parseBlock(p)
+		arms = append(arms, arm)
+		p.expect(lexer.COMMA)
 	}
 	p.expect(lexer.RPAR)
 	p.expect(lexer.SEMICOLON)
+	return &ast.MatchStatement{
+		Value: value,
+		Arms:  arms,
+	}
+}
 
-	return &ast.ClassDecStatement{
-		Name:      className,
-		Fields:    fields,
-		Functions: functions,
+// isBindingList отличает список имён варианта Ok(value) от тела ветки match:
+// за списком имён обязательно следует открывающая скобка тела.
+func (p *parser) isBindingList() bool {
+	if p.getCurrToken().Kind != lexer.LPAR {
+		return false
+	}
+	offset := 1
+	for p.peek(offset) == lexer.IDENT {
+		offset++
+		if p.peek(offset) != lexer.COMMA {
+			break
+		}
+		offset++
 	}
+	return p.peek(offset) == lexer.RPAR && p.peek(offset+1) == lexer.LPAR
 }
 
 func parseFunctionDeclaration(p *parser) ast.Statement {
 	p.expect(lexer.VOID)
 	functionName := p.expect(lexer.IDENT).Value
+	typeParameters := parseTypeParameters(p)
+	params := parseParameters(p)
+	returnValues := parseReturnTypes(p)
+	body := parseBlock(p)
+	p.expect(lexer.SEMICOLON)
+	return &ast.FunctionDecStatement{
+		Name:           functionName,
+		TypeParameters: typeParameters,
+		Parameters:     params,
+		ReturnType:     returnValues,
+		Body:           body,
+	}
+}
+
+func parseParameters(p *parser) []ast.VariableDecStatement {
 	p.expect(lexer.LPAR)
 	var params = make([]ast.VariableDecStatement, 0)
 	for p.hasTokens() && p.getCurrToken().Kind != lexer.RPAR {
 		var paramName string
 		var paramType ast.Type
+		var defaultValue ast.Expression
+		var isVariadic bool
 		paramName = p.expect(lexer.IDENT).Value
+		if p.getCurrToken().Kind == lexer.ELLIPSIS {
+			p.advance()
+			isVariadic = true
+		}
 		paramType = parseType(p, default_power)
+		if p.getCurrToken().Kind == lexer.ASSIGN {
+			p.advance()
+			defaultValue = parseExpression(p, LOGICAL)
+		}
 		if p.getCurrToken().Kind != lexer.RPAR {
 			p.expect(lexer.COMMA)
 		}
@@ -143,11 +340,16 @@ func parseFunctionDeclaration(p *parser) ast.Statement {
 		params = append(params, ast.VariableDecStatement{
 			Names:         names,
 			IsConstant:    false,
-			AssignedValue: nil,
+			IsVariadic:    isVariadic,
+			AssignedValue: defaultValue,
 			Type:          paramType,
 		})
 	}
 	p.expect(lexer.RPAR)
+	return params
+}
+
+func parseReturnTypes(p *parser) []ast.Type {
 	p.expect(lexer.LPAR)
 	var returnValues []ast.Type
 	for p.hasTokens() && p.getCurrToken().Kind != lexer.RPAR {
@@ -157,21 +359,7 @@ func parseFunctionDeclaration(p *parser) ast.Statement {
 		}
 	}
 	p.expect(lexer.RPAR)
-	p.expect(lexer.LPAR)
-	var body []ast.Statement
-	for p.hasTokens() && p.getCurrToken().Kind != lexer.RPAR {
-		body = append(body, parseStatement(p))
-	}
-	p.expect(lexer.RPAR)
-	p.expect(lexer.SEMICOLON)
-	return &ast.FunctionDecStatement{
-		Name:       functionName,
-		Parameters: params,
-		ReturnType: returnValues,
-		Body: &ast.BlockStatement{
-			Statements: body,
-		},
-	}
+	return returnValues
 }
 
 func parseReturnStatement(p *parser) ast.Statement {
@@ -247,6 +435,14 @@ func parseWhileStatement(p *parser) ast.Statement {
 	}
 }
 
+func parseVisibilityStatement(p *parser) ast.Statement {
+	isPrivate := p.advance().Kind == lexer.PRIVATE
+	return &ast.VisibilityStatement{
+		IsPrivate: isPrivate,
+		Statement: parseStatement(p),
+	}
+}
+
 func parseImportStatement(p *parser) ast.Statement {
 	p.expect(lexer.IMPORT)
 	name := p.expect(lexer.IDENT).Value
@@ -257,3 +453,54 @@ func parseImportStatement(p *parser) ast.Statement {
 		PackagePath: path,
 	}
 }
+
+func parseThrowStatement(p *parser) ast.Statement {
+	p.expect(lexer.THROW)
+	value := parseExpression(p, default_power)
+	p.expect(lexer.SEMICOLON)
+	return &ast.ThrowStatement{
+		Value: value,
+	}
+}
+
+func parseTryStatement(p *parser) ast.Statement {
+	p.expect(lexer.TRY)
+	body := parseBlock(p)
+	var catchName string
+	var catchBlock *ast.BlockStatement
+	var finallyBlock *ast.BlockStatement
+	if p.getCurrToken().Kind == lexer.CATCH {
+		p.advance()
+		p.expect(lexer.LPAR)
+		catchName = p.expect(lexer.IDENT).Value
+		p.expect(lexer.RPAR)
+		catchBlock = parseBlock(p)
+	}
+	if p.getCurrToken().Kind == lexer.FINALLY {
+		p.advance()
+		finallyBlock = parseBlock(p)
+	}
+	if catchBlock == nil && finallyBlock == nil {
+		panic("!! После блока try ожидается catch или finally")
+	}
+	p.expect(lexer.SEMICOLON)
+	return &ast.TryStatement{
+		Body:         body,
+		CatchName:    catchName,
+		CatchBlock:   catchBlock,
+		FinallyBlock: finallyBlock,
+	}
+}
+
+// parseBlock разбирает последовательность инструкций в круглых скобках.
+func parseBlock(p *parser) *ast.BlockStatement {
+	p.expect(lexer.LPAR)
+	var body []ast.Statement
+	for p.hasTokens() && p.getCurrToken().Kind != lexer.RPAR {
+		body = append(body, parseStatement(p))
+	}
+	p.expect(lexer.RPAR)
+	return &ast.BlockStatement{
+		Statements: body,
+	}
+}
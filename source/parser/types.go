@@ -34,9 +34,59 @@ func createTokenTypeLookups() {
 }
 
 func parseSymbolType(p *parser) ast.Type {
+	name := p.expect(lexer.IDENT).Value
+	if name == "map" && p.getCurrToken().Kind == lexer.LBRAK {
+		return parseMapType(p)
+	}
 	return &ast.SymbolType{
-		Name: p.expect(lexer.IDENT).Value,
+		Name:      name,
+		Arguments: parseTypeArguments(p),
+	}
+}
+
+// parseMapType разбирает тип map[K]V; слово map уже прочитано.
+func parseMapType(p *parser) ast.Type {
+	p.expect(lexer.LBRAK)
+	keyType := parseType(p, PRIMARY)
+	p.expect(lexer.RBRAK)
+	return &ast.MapType{
+		Key:   keyType,
+		Value: parseType(p, PRIMARY),
+	}
+}
+
+// parseTypeArguments разбирает необязательный список типов <int, string>.
+func parseTypeArguments(p *parser) []ast.Type {
+	if p.getCurrToken().Kind != lexer.LESS {
+		return nil
 	}
+	p.advance()
+	var arguments []ast.Type
+	for p.hasTokens() && p.getCurrToken().Kind != lexer.GREATER {
+		arguments = append(arguments, parseType(p, default_power))
+		if p.getCurrToken().Kind != lexer.GREATER {
+			p.expect(lexer.COMMA)
+		}
+	}
+	p.expect(lexer.GREATER)
+	return arguments
+}
+
+// parseTypeParameters разбирает необязательный список параметров типа <T, U>.
+func parseTypeParameters(p *parser) []string {
+	if p.getCurrToken().Kind != lexer.LESS {
+		return nil
+	}
+	p.advance()
+	var parameters []string
+	for p.hasTokens() && p.getCurrToken().Kind != lexer.GREATER {
+		parameters = append(parameters, p.expect(lexer.IDENT).Value)
+		if p.getCurrToken().Kind != lexer.GREATER {
+			p.expect(lexer.COMMA)
+		}
+	}
+	p.expect(lexer.GREATER)
+	return parameters
 }
 
 func parseArrayType(p *parser) ast.Type {
@@ -58,14 +108,18 @@ func parseType(p *parser, bp binding_power) ast.Type {
 		return nil
 	}
 	left := nud_func(p)
-	for bp_lu[lexer.GetTokenKind(p.getCurrToken())] > bp {
+	for type_bp_lu[lexer.GetTokenKind(p.getCurrToken())] > bp {
 		tokenKind = lexer.GetTokenKind(p.getCurrToken())
 		led_func, exists := type_led_lu[tokenKind]
 		if !exists {
 			return nil
 		}
 
-		left = led_func(p, left, bp_lu[p.getCurrToken().Kind])
+		left = led_func(p, left, type_bp_lu[p.getCurrToken().Kind])
+	}
+	if p.getCurrToken().Kind == lexer.QUESTION {
+		p.advance()
+		return &ast.NullableType{Underlying: left}
 	}
 	return left
 }
@@ -0,0 +1,239 @@
+package runner
+
+import (
+	"fmt"
+	"io"
+	"meow/source/ast"
+	"meow/source/runner/object"
+	"strings"
+)
+
+// builtins — встроенные функции, доступные в любой программе. Функции
+// программы с тем же именем скрывают встроенные.
+var builtins = map[string]*object.Builtin{}
+
+// RegisterBuiltin добавляет встроенную функцию или заменяет функцию
+// с тем же именем.
+func RegisterBuiltin(builtin *object.Builtin) {
+	builtins[builtin.Name] = builtin
+}
+
+func init() {
+	RegisterBuiltin(&object.Builtin{Name: "meow", Arity: -1, Fn: builtinMeow})
+	RegisterBuiltin(&object.Builtin{Name: "print", Arity: -1, Fn: builtinPrint})
+	RegisterBuiltin(&object.Builtin{Name: "println", Arity: -1, Fn: builtinPrintln})
+	RegisterBuiltin(&object.Builtin{Name: "eprint", Arity: -1, Fn: builtinEprint})
+	RegisterBuiltin(&object.Builtin{Name: "eprintln", Arity: -1, Fn: builtinEprintln})
+	RegisterBuiltin(&object.Builtin{
+		Name:       "format",
+		Arity:      -1,
+		ParamTypes: []object.ObjectType{object.STRING},
+		Fn:         builtinFormat,
+	})
+	RegisterBuiltin(&object.Builtin{
+		Name:       "printf",
+		Arity:      -1,
+		ParamTypes: []object.ObjectType{object.STRING},
+		Fn:         builtinPrintf,
+	})
+	RegisterBuiltin(&object.Builtin{Name: "input", Arity: -1, Fn: builtinInput})
+	RegisterBuiltin(&object.Builtin{Name: "readLine", Arity: 0, Fn: builtinReadLine})
+	RegisterBuiltin(&object.Builtin{Name: "exit", Arity: -1, Fn: builtinExit})
+	RegisterBuiltin(&object.Builtin{Name: "len", Arity: 1, Fn: builtinLen})
+	RegisterBuiltin(&object.Builtin{
+		Name:       "tail",
+		Arity:      2,
+		ParamTypes: []object.ObjectType{object.ARRAY},
+		Fn:         builtinTail,
+	})
+	RegisterBuiltin(&object.Builtin{Name: "typeof", Arity: 1, Fn: builtinTypeof})
+	RegisterBuiltin(&object.Builtin{Name: "int", Arity: 1, Fn: unary(convertToInt)})
+	RegisterBuiltin(&object.Builtin{Name: "float", Arity: 1, Fn: unary(convertToFloat)})
+	RegisterBuiltin(&object.Builtin{Name: "bool", Arity: 1, Fn: unary(convertToBool)})
+	RegisterBuiltin(&object.Builtin{Name: "string", Arity: 1, Fn: unary(convertToString)})
+}
+
+func evaluateBuiltinCall(builtin *object.Builtin, node *ast.FunctionInstance, env *object.Environment) object.Object {
+	if len(node.NamedParameters) > 0 {
+		return newError("Аргументы встроенной функции %s передаются только по порядку", builtin.Name)
+	}
+	args := EvaluateExpressions(node.Parameters, env)
+	for _, arg := range args {
+		if IsError(arg) {
+			return arg
+		}
+	}
+	return callBuiltin(builtin, args, env)
+}
+
+// CallFunction вызывает функцию программы или встроенную функцию с уже
+// вычисленными аргументами, проверяя их так же, как при вызове из кода.
+func CallFunction(fn object.Object, args []object.Object, env *object.Environment) object.Object {
+	switch fn := fn.(type) {
+	case *object.Builtin:
+		return callBuiltin(fn, args, env)
+	case *object.FunctionLiteral:
+		if fn.IsMethod {
+			return newError("Метод %s можно вызвать только у объекта", fn.Name)
+		}
+		bound, err := bindValues(fn, fn.Name, args, nil, nil)
+		if err != nil {
+			return err
+		}
+		return applyFunction(fn, bound)
+	}
+	return newError("%s не является функцией", fn.Type())
+}
+
+// callBuiltin проверяет число и типы аргументов и вызывает встроенную функцию.
+func callBuiltin(builtin *object.Builtin, args []object.Object, env *object.Environment) object.Object {
+	if builtin.Arity >= 0 && len(args) != builtin.Arity {
+		return newError("Неверное число аргументов для функции %s. Ожидается %d, но получено %d",
+			builtin.Name, builtin.Arity, len(args))
+	}
+	for i, paramType := range builtin.ParamTypes {
+		if i < len(args) && paramType != "" && args[i].Type() != paramType {
+			return newError("Неверный аргумент %s функции %s: ожидается %s", args[i].Type(), builtin.Name, paramType)
+		}
+	}
+	result := builtin.Fn(env, args...)
+	if result == nil {
+		return NULL
+	}
+	return result
+}
+
+func unary(fn func(object.Object) object.Object) object.BuiltinFunction {
+	return func(env *object.Environment, args ...object.Object) object.Object {
+		return fn(args[0])
+	}
+}
+
+func builtinMeow(env *object.Environment, args ...object.Object) object.Object {
+	texts, err := toDisplayStrings(args)
+	if err != nil {
+		return err
+	}
+	stdout := env.Runtime().Stdout
+	if len(texts) == 1 {
+		fmt.Fprintln(stdout, texts[0])
+		return NULL
+	}
+	for _, text := range texts {
+		fmt.Fprint(stdout, text)
+	}
+	return NULL
+}
+
+// writeArgs выводит аргументы через пробел, а после них — end.
+func writeArgs(w io.Writer, args []object.Object, end string) object.Object {
+	texts, err := toDisplayStrings(args)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprint(w, strings.Join(texts, " ")+end); err != nil {
+		return newError("Ошибка вывода: %s", err)
+	}
+	return NULL
+}
+
+func builtinPrint(env *object.Environment, args ...object.Object) object.Object {
+	return writeArgs(env.Runtime().Stdout, args, "")
+}
+
+func builtinPrintln(env *object.Environment, args ...object.Object) object.Object {
+	return writeArgs(env.Runtime().Stdout, args, "\n")
+}
+
+func builtinEprint(env *object.Environment, args ...object.Object) object.Object {
+	return writeArgs(env.Runtime().Stderr, args, "")
+}
+
+func builtinEprintln(env *object.Environment, args ...object.Object) object.Object {
+	return writeArgs(env.Runtime().Stderr, args, "\n")
+}
+
+func builtinFormat(env *object.Environment, args ...object.Object) object.Object {
+	if len(args) == 0 {
+		return newError("Функции format нужна строка формата")
+	}
+	return formatString(string(args[0].(*object.String).Value), args[1:])
+}
+
+func builtinPrintf(env *object.Environment, args ...object.Object) object.Object {
+	if len(args) == 0 {
+		return newError("Функции printf нужна строка формата")
+	}
+	text := formatString(string(args[0].(*object.String).Value), args[1:])
+	if IsError(text) {
+		return text
+	}
+	return writeArgs(env.Runtime().Stdout, []object.Object{text}, "")
+}
+
+// builtinInput выводит необязательное приглашение и читает строку ввода.
+func builtinInput(env *object.Environment, args ...object.Object) object.Object {
+	if len(args) > 1 {
+		return newError("Неверное число аргументов для функции input. Ожидается не больше 1, но получено %d", len(args))
+	}
+	if len(args) == 1 {
+		if err := writeArgs(env.Runtime().Stdout, args, ""); IsError(err) {
+			return err
+		}
+	}
+	return builtinReadLine(env)
+}
+
+// builtinReadLine возвращает строку ввода или null, если ввод закончился.
+func builtinReadLine(env *object.Environment, args ...object.Object) object.Object {
+	line, ok, err := env.Runtime().ReadLine()
+	if err != nil {
+		return newError("Ошибка чтения ввода: %s", err)
+	}
+	if !ok {
+		return NULL
+	}
+	return &object.String{Value: []rune(line)}
+}
+
+func builtinLen(env *object.Environment, args ...object.Object) object.Object {
+	switch val := args[0].(type) {
+	case *object.String:
+		return &object.Integer{Value: int64(len(val.Value))}
+	case *object.Array:
+		return &object.Integer{Value: int64(len(val.Elements))}
+	case *object.Map:
+		return &object.Integer{Value: int64(len(val.Keys))}
+	}
+	return newError("Невозможно высчитать длину типа %s", args[0].Type())
+}
+
+func builtinTail(env *object.Environment, args ...object.Object) object.Object {
+	if err := env.Runtime().Allocate(); err != nil {
+		return err
+	}
+	arr := args[0].(*object.Array)
+	elementsType := arr.ElementsType
+	// у пустого массива [] тип элементов ещё не известен: его задаёт первый элемент
+	if elementsType == "" && len(arr.Elements) == 0 {
+		elementsType = args[1].Type()
+	}
+	if args[1].Type() != elementsType {
+		return newError("Второй аргумент функции tail должен быть %s", elementsType)
+	}
+	length := len(arr.Elements)
+	newElements := make([]object.Object, length+1)
+	copy(newElements, arr.Elements)
+	newElements[length] = args[1]
+	return &object.Array{Elements: newElements, ElementsType: elementsType}
+}
+
+func builtinTypeof(env *object.Environment, args ...object.Object) object.Object {
+	return &object.String{Value: []rune(typeOfName(args[0]))}
+}
+
+// LookupBuiltin возвращает встроенную функцию по имени.
+func LookupBuiltin(name string) (*object.Builtin, bool) {
+	builtin, ok := builtins[name]
+	return builtin, ok
+}
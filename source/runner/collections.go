@@ -0,0 +1,389 @@
+package runner
+
+import (
+	"meow/source/lexer"
+	"meow/source/runner/object"
+	"sort"
+)
+
+func init() {
+	array := object.ARRAY
+	integer := object.INTEGER
+	RegisterBuiltin(&object.Builtin{Name: "push", Arity: -1, ParamTypes: []object.ObjectType{array}, Fn: builtinPush})
+	RegisterBuiltin(&object.Builtin{Name: "pop", Arity: 1, ParamTypes: []object.ObjectType{array}, Fn: builtinPop})
+	RegisterBuiltin(&object.Builtin{Name: "insert", Arity: 3, ParamTypes: []object.ObjectType{array, integer}, Fn: builtinInsert})
+	RegisterBuiltin(&object.Builtin{Name: "remove", Arity: 2, ParamTypes: []object.ObjectType{array, integer}, Fn: builtinRemove})
+	RegisterBuiltin(&object.Builtin{Name: "sort", Arity: -1, ParamTypes: []object.ObjectType{array}, Fn: builtinSort})
+	RegisterBuiltin(&object.Builtin{Name: "reverse", Arity: 1, ParamTypes: []object.ObjectType{array}, Fn: builtinReverse})
+	RegisterBuiltin(&object.Builtin{Name: "contains", Arity: 2, ParamTypes: []object.ObjectType{array}, Fn: builtinContains})
+	RegisterBuiltin(&object.Builtin{Name: "indexOf", Arity: 2, ParamTypes: []object.ObjectType{array}, Fn: builtinIndexOf})
+	RegisterBuiltin(&object.Builtin{Name: "map", Arity: 2, ParamTypes: []object.ObjectType{array}, Fn: builtinMap})
+	RegisterBuiltin(&object.Builtin{Name: "filter", Arity: 2, ParamTypes: []object.ObjectType{array}, Fn: builtinFilter})
+	RegisterBuiltin(&object.Builtin{Name: "reduce", Arity: 3, ParamTypes: []object.ObjectType{array}, Fn: builtinReduce})
+	RegisterBuiltin(&object.Builtin{Name: "any", Arity: 2, ParamTypes: []object.ObjectType{array}, Fn: builtinAny})
+	RegisterBuiltin(&object.Builtin{Name: "all", Arity: 2, ParamTypes: []object.ObjectType{array}, Fn: builtinAll})
+	RegisterBuiltin(&object.Builtin{Name: "range", Arity: -1, ParamTypes: []object.ObjectType{integer, integer, integer}, Fn: builtinRange})
+	RegisterBuiltin(&object.Builtin{Name: "zip", Arity: 2, ParamTypes: []object.ObjectType{array, array}, Fn: builtinZip})
+	RegisterBuiltin(&object.Builtin{Name: "unique", Arity: 1, ParamTypes: []object.ObjectType{array}, Fn: builtinUnique})
+}
+
+// checkElement проверяет, что значение можно положить в массив; тип
+// пустого нетипизированного массива задаётся первым элементом.
+func checkElement(arr *object.Array, value object.Object) object.Object {
+	if arr.ElementsType == "" {
+		arr.ElementsType = value.Type()
+	}
+	if value.Type() != arr.ElementsType {
+		return newError("Элемент массива должен быть %s, получено %s", arr.ElementsType, value.Type())
+	}
+	return nil
+}
+
+// newArrayOf создаёт массив из значений, проверяя, что они одного типа.
+func newArrayOf(elements []object.Object, env *object.Environment) object.Object {
+	if err := env.Runtime().Allocate(); err != nil {
+		return err
+	}
+	arr := &object.Array{Elements: elements}
+	for _, elem := range elements {
+		if err := checkElement(arr, elem); err != nil {
+			return newError("Все элементы массива должны быть одного типа")
+		}
+	}
+	return arr
+}
+
+func arrayIndex(index object.Object, limit int) (int, object.Object) {
+	i := index.(*object.Integer).Value
+	if i < 0 || i > int64(limit) {
+		return 0, newError("Индекс выходит за границы массива")
+	}
+	return int(i), nil
+}
+
+// callback вызывает функцию, переданную во встроенную функцию.
+func callback(fn object.Object, env *object.Environment, args ...object.Object) object.Object {
+	switch fn.(type) {
+	case *object.FunctionLiteral, *object.Builtin:
+		return CallFunction(fn, args, env)
+	}
+	return newError("Ожидалась функция, получено %s", fn.Type())
+}
+
+// predicate вызывает функцию, которая должна вернуть bool.
+func predicate(fn object.Object, env *object.Environment, args ...object.Object) (bool, object.Object) {
+	result := callback(fn, env, args...)
+	if IsError(result) {
+		return false, result
+	}
+	if result.Type() != object.BOOLEAN {
+		return false, newError("Функция должна возвращать bool, получено %s", result.Type())
+	}
+	return result == TRUE, nil
+}
+
+// valuesEqual сравнивает значения так же, как оператор ==.
+func valuesEqual(left, right object.Object) (bool, object.Object) {
+	if left.Type() != right.Type() {
+		return false, nil
+	}
+	result := evaluateBOExpression(lexer.EQUALS, left, right)
+	if IsError(result) {
+		return false, result
+	}
+	return isTruthy(result), nil
+}
+
+func indexOf(arr *object.Array, value object.Object) (int, object.Object) {
+	for i, elem := range arr.Elements {
+		equal, err := valuesEqual(elem, value)
+		if err != nil {
+			return 0, err
+		}
+		if equal {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+func builtinPush(env *object.Environment, args ...object.Object) object.Object {
+	arr := args[0].(*object.Array)
+	for _, value := range args[1:] {
+		if err := checkElement(arr, value); err != nil {
+			return err
+		}
+	}
+	arr.Elements = append(arr.Elements, args[1:]...)
+	return NULL
+}
+
+func builtinPop(env *object.Environment, args ...object.Object) object.Object {
+	arr := args[0].(*object.Array)
+	if len(arr.Elements) == 0 {
+		return newError("Невозможно извлечь элемент из пустого массива")
+	}
+	last := arr.Elements[len(arr.Elements)-1]
+	arr.Elements = arr.Elements[:len(arr.Elements)-1]
+	return last
+}
+
+func builtinInsert(env *object.Environment, args ...object.Object) object.Object {
+	arr := args[0].(*object.Array)
+	index, err := arrayIndex(args[1], len(arr.Elements))
+	if err != nil {
+		return err
+	}
+	if err := checkElement(arr, args[2]); err != nil {
+		return err
+	}
+	arr.Elements = append(arr.Elements, nil)
+	copy(arr.Elements[index+1:], arr.Elements[index:])
+	arr.Elements[index] = args[2]
+	return NULL
+}
+
+func builtinRemove(env *object.Environment, args ...object.Object) object.Object {
+	arr := args[0].(*object.Array)
+	index, err := arrayIndex(args[1], len(arr.Elements)-1)
+	if err != nil {
+		return err
+	}
+	removed := arr.Elements[index]
+	arr.Elements = append(arr.Elements[:index], arr.Elements[index+1:]...)
+	return removed
+}
+
+// builtinSort сортирует массив на месте оператором < или функцией
+// сравнения: она возвращает bool «меньше» либо int со знаком разницы.
+func builtinSort(env *object.Environment, args ...object.Object) object.Object {
+	if len(args) > 2 {
+		return newError("Неверное число аргументов для функции sort. Ожидается 1 или 2, но получено %d", len(args))
+	}
+	arr := args[0].(*object.Array)
+	var failure object.Object
+	less := func(a, b object.Object) bool {
+		var result object.Object
+		if len(args) == 2 {
+			result = callback(args[1], env, a, b)
+		} else {
+			result = lessThan(a, b)
+		}
+		switch result := result.(type) {
+		case *object.Boolean:
+			return result.Value
+		case *object.Integer:
+			return result.Value < 0
+		case *object.Error:
+			failure = result
+		default:
+			failure = newError("Функция сравнения должна возвращать bool или int, получено %s", result.Type())
+		}
+		return false
+	}
+	sort.SliceStable(arr.Elements, func(i, j int) bool {
+		return failure == nil && less(arr.Elements[i], arr.Elements[j])
+	})
+	if failure != nil {
+		return failure
+	}
+	return NULL
+}
+
+// lessThan сравнивает значения оператором <; строки, для которых
+// оператора нет, сравниваются посимвольно.
+func lessThan(left, right object.Object) object.Object {
+	if left.Type() == object.STRING && right.Type() == object.STRING {
+		return nativeBoolToBooleanObject(goString(left) < goString(right))
+	}
+	return evaluateBOExpression(lexer.LESS, left, right)
+}
+
+func builtinReverse(env *object.Environment, args ...object.Object) object.Object {
+	elements := args[0].(*object.Array).Elements
+	for i, j := 0, len(elements)-1; i < j; i, j = i+1, j-1 {
+		elements[i], elements[j] = elements[j], elements[i]
+	}
+	return NULL
+}
+
+func builtinContains(env *object.Environment, args ...object.Object) object.Object {
+	index, err := indexOf(args[0].(*object.Array), args[1])
+	if err != nil {
+		return err
+	}
+	return nativeBoolToBooleanObject(index >= 0)
+}
+
+func builtinIndexOf(env *object.Environment, args ...object.Object) object.Object {
+	index, err := indexOf(args[0].(*object.Array), args[1])
+	if err != nil {
+		return err
+	}
+	return &object.Integer{Value: int64(index)}
+}
+
+func builtinMap(env *object.Environment, args ...object.Object) object.Object {
+	elements := args[0].(*object.Array).Elements
+	mapped := make([]object.Object, 0, len(elements))
+	for _, elem := range elements {
+		result := callback(args[1], env, elem)
+		if IsError(result) {
+			return result
+		}
+		mapped = append(mapped, result)
+	}
+	return newArrayOf(mapped, env)
+}
+
+func builtinFilter(env *object.Environment, args ...object.Object) object.Object {
+	arr := args[0].(*object.Array)
+	filtered := make([]object.Object, 0, len(arr.Elements))
+	for _, elem := range arr.Elements {
+		keep, err := predicate(args[1], env, elem)
+		if err != nil {
+			return err
+		}
+		if keep {
+			filtered = append(filtered, elem)
+		}
+	}
+	if err := env.Runtime().Allocate(); err != nil {
+		return err
+	}
+	return &object.Array{Elements: filtered, ElementsType: arr.ElementsType}
+}
+
+func builtinReduce(env *object.Environment, args ...object.Object) object.Object {
+	accumulator := args[2]
+	for _, elem := range args[0].(*object.Array).Elements {
+		accumulator = callback(args[1], env, accumulator, elem)
+		if IsError(accumulator) {
+			return accumulator
+		}
+	}
+	return accumulator
+}
+
+func builtinAny(env *object.Environment, args ...object.Object) object.Object {
+	for _, elem := range args[0].(*object.Array).Elements {
+		ok, err := predicate(args[1], env, elem)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return TRUE
+		}
+	}
+	return FALSE
+}
+
+func builtinAll(env *object.Environment, args ...object.Object) object.Object {
+	for _, elem := range args[0].(*object.Array).Elements {
+		ok, err := predicate(args[1], env, elem)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return FALSE
+		}
+	}
+	return TRUE
+}
+
+// builtinRange принимает range(end), range(start, end) или
+// range(start, end, step); end в результат не входит.
+func builtinRange(env *object.Environment, args ...object.Object) object.Object {
+	if len(args) == 0 || len(args) > 3 {
+		return newError("Неверное число аргументов для функции range. Ожидается от 1 до 3, но получено %d", len(args))
+	}
+	bounds := []int64{0, 0, 1}
+	if len(args) == 1 {
+		bounds[1] = args[0].(*object.Integer).Value
+	} else {
+		for i, arg := range args {
+			bounds[i] = arg.(*object.Integer).Value
+		}
+	}
+	start, end, step := bounds[0], bounds[1], bounds[2]
+	if step == 0 {
+		return newError("Шаг функции range не может быть нулевым")
+	}
+	count := rangeLength(start, end, step)
+	if count > maxArrayLength {
+		return newError("Результат range длиннее %d элементов", maxArrayLength)
+	}
+	if err := env.Runtime().Allocate(); err != nil {
+		return err
+	}
+	elements := make([]object.Object, 0, min(count, 1<<16))
+	value := start
+	for i := uint64(0); i < count; i++ {
+		// Каждое число — отдельный объект, так что лимиты проверяются по ходу.
+		if err := env.Runtime().Step(); err != nil {
+			return err
+		}
+		if err := env.Runtime().Allocate(); err != nil {
+			return err
+		}
+		elements = append(elements, &object.Integer{Value: value})
+		value += step
+	}
+	return &object.Array{Elements: elements, ElementsType: object.INTEGER}
+}
+
+// maxArrayLength ограничивает длину массивов, которые строят встроенные функции.
+const maxArrayLength = 1 << 26
+
+// rangeLength считает число элементов range без переполнения int64.
+func rangeLength(start, end, step int64) uint64 {
+	switch {
+	case step > 0 && start < end:
+		return (uint64(end)-uint64(start)-1)/uint64(step) + 1
+	case step < 0 && start > end:
+		return (uint64(start)-uint64(end)-1)/(uint64(-(step+1))+1) + 1
+	}
+	return 0
+}
+
+// builtinZip составляет массив пар-кортежей; лишние элементы более
+// длинного массива отбрасываются.
+func builtinZip(env *object.Environment, args ...object.Object) object.Object {
+	left, right := args[0].(*object.Array).Elements, args[1].(*object.Array).Elements
+	length := min(len(left), len(right))
+	if err := env.Runtime().Allocate(); err != nil {
+		return err
+	}
+	pairs := make([]object.Object, 0, length)
+	for i := 0; i < length; i++ {
+		pairs = append(pairs, &object.Tuple{Elements: []object.Object{left[i], right[i]}})
+	}
+	return &object.Array{Elements: pairs, ElementsType: object.TUPLE}
+}
+
+func builtinUnique(env *object.Environment, args ...object.Object) object.Object {
+	arr := args[0].(*object.Array)
+	seen := map[object.MapKey]bool{}
+	unique := &object.Array{ElementsType: arr.ElementsType}
+	for _, elem := range arr.Elements {
+		if key, ok := object.KeyOf(elem); ok {
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+		} else {
+			index, err := indexOf(unique, elem)
+			if err != nil {
+				return err
+			}
+			if index >= 0 {
+				continue
+			}
+		}
+		unique.Elements = append(unique.Elements, elem)
+	}
+	if err := env.Runtime().Allocate(); err != nil {
+		return err
+	}
+	return unique
+}
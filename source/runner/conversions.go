@@ -0,0 +1,108 @@
+package runner
+
+import (
+	"math"
+	"meow/source/runner/object"
+	"strconv"
+	"strings"
+)
+
+// Преобразования между простыми типами: int(), float(), bool() и string().
+
+func convertToInt(arg object.Object) object.Object {
+	switch arg := arg.(type) {
+	case *object.Integer:
+		return arg
+	case *object.Float:
+		if math.IsNaN(arg.Value) || math.IsInf(arg.Value, 0) ||
+			arg.Value >= math.MaxInt64 || arg.Value < math.MinInt64 {
+			return newError("Невозможно преобразовать %s в int", arg.Inspect())
+		}
+		return &object.Integer{Value: int64(arg.Value)}
+	case *object.Boolean:
+		if arg.Value {
+			return &object.Integer{Value: 1}
+		}
+		return &object.Integer{Value: 0}
+	case *object.String:
+		value, err := strconv.ParseInt(strings.TrimSpace(string(arg.Value)), 10, 64)
+		if err != nil {
+			return newError("Невозможно преобразовать строку \"%s\" в int", string(arg.Value))
+		}
+		return &object.Integer{Value: value}
+	}
+	return newError("Невозможно привести тип данных %s к int", arg.Type())
+}
+
+func convertToFloat(arg object.Object) object.Object {
+	switch arg := arg.(type) {
+	case *object.Float:
+		return arg
+	case *object.Integer:
+		return &object.Float{Value: float64(arg.Value)}
+	case *object.Boolean:
+		if arg.Value {
+			return &object.Float{Value: 1}
+		}
+		return &object.Float{Value: 0}
+	case *object.String:
+		value, err := strconv.ParseFloat(strings.TrimSpace(string(arg.Value)), 64)
+		if err != nil {
+			return newError("Невозможно преобразовать строку \"%s\" в float", string(arg.Value))
+		}
+		return &object.Float{Value: value}
+	}
+	return newError("Невозможно привести тип данных %s к float", arg.Type())
+}
+
+func convertToBool(arg object.Object) object.Object {
+	switch arg := arg.(type) {
+	case *object.Boolean:
+		return arg
+	case *object.Integer:
+		return nativeBoolToBooleanObject(arg.Value != 0)
+	case *object.Float:
+		return nativeBoolToBooleanObject(arg.Value != 0)
+	case *object.String:
+		switch strings.TrimSpace(string(arg.Value)) {
+		case "true":
+			return TRUE
+		case "false":
+			return FALSE
+		}
+		return newError("Невозможно преобразовать строку \"%s\" в bool", string(arg.Value))
+	}
+	return newError("Невозможно привести тип данных %s к bool", arg.Type())
+}
+
+func convertToString(arg object.Object) object.Object {
+	switch arg := arg.(type) {
+	case *object.String:
+		return arg
+	case *object.Class:
+		if _, fn := findMethod(arg, toStringMethod); fn == nil {
+			return newError("Невозможно привести объект %s к строке: класс не определяет %s", arg.Name, toStringMethod)
+		}
+	case *object.FunctionLiteral, *object.Module, *object.Enum, *object.Interface:
+		return newError("Невозможно привести тип данных %s к строке", arg.Type())
+	}
+	text, err := toDisplayString(arg)
+	if err != nil {
+		return err
+	}
+	return &object.String{Value: []rune(text)}
+}
+
+// typeOfName возвращает имя типа значения для typeof: для объектов
+// классов и перечислений — имя класса или перечисления.
+func typeOfName(arg object.Object) string {
+	switch arg := arg.(type) {
+	case *object.Class:
+		if arg.Class != nil {
+			return arg.Name
+		}
+	case *object.EnumValue:
+		return arg.Enum.Name
+	}
+	return string(arg.Type())
+}
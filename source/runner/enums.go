@@ -0,0 +1,131 @@
+package runner
+
+import (
+	"meow/source/ast"
+	"meow/source/lexer"
+	"meow/source/runner/object"
+	"strings"
+)
+
+func ExecuteEnumDec(node ast.EnumDecStatement, env *object.Environment) object.Object {
+	enum := &object.Enum{
+		Name:     node.Name,
+		Variants: node.Variants,
+	}
+	env.Set(node.Name, enum)
+	return enum
+}
+
+// evaluateEnumMember возвращает вариант перечисления: Color.Red без данных
+// или Result.Ok(5) с данными, проверяя их типы.
+func evaluateEnumMember(enum *object.Enum, memberName string, member ast.Expression, env *object.Environment) object.Object {
+	variant, ok := enum.Variant(memberName)
+	if !ok {
+		return newError("Вариант %s не найден в перечислении %s", memberName, enum.Name)
+	}
+	call, isCall := member.(*ast.FunctionInstance)
+	if !isCall {
+		if len(variant.Types) > 0 {
+			return newError("Вариант %s.%s требует данных: %d", enum.Name, variant.Name, len(variant.Types))
+		}
+		return &object.EnumValue{Enum: enum, Variant: variant.Name}
+	}
+	if len(call.NamedParameters) > 0 {
+		return newError("Данные варианта %s.%s передаются только по порядку", enum.Name, variant.Name)
+	}
+	values := EvaluateExpressions(call.Parameters, env)
+	if len(values) != len(variant.Types) {
+		return newError("Неверное число данных для варианта %s.%s. Ожидается %d, но получено %d",
+			enum.Name, variant.Name, len(variant.Types), len(values))
+	}
+	for i, value := range values {
+		if IsError(value) {
+			return value
+		}
+		if !checkParamType(value, variant.Types[i]) {
+			return newError("Неверное значение %s для варианта %s.%s: ожидается %s",
+				value.Inspect(), enum.Name, variant.Name, typeName(variant.Types[i]))
+		}
+	}
+	return &object.EnumValue{Enum: enum, Variant: variant.Name, Values: values}
+}
+
+func ExecuteMatch(node ast.MatchStatement, env *object.Environment) object.Object {
+	value := Evaluate(node.Value, env)
+	if IsError(value) {
+		return value
+	}
+	enumValue, ok := value.(*object.EnumValue)
+	if !ok {
+		return newError("match ожидает значение перечисления, получено %s", value.Type())
+	}
+	enum := enumValue.Enum
+	covered := make(map[string]bool)
+	wildcard := false
+	for _, arm := range node.Arms {
+		if arm.Variant == "_" {
+			wildcard = true
+			continue
+		}
+		if _, ok := enum.Variant(arm.Variant); !ok {
+			return newError("Вариант %s не найден в перечислении %s", arm.Variant, enum.Name)
+		}
+		covered[arm.Variant] = true
+	}
+	if !wildcard {
+		var missing []string
+		for _, variant := range enum.Variants {
+			if !covered[variant.Name] {
+				missing = append(missing, variant.Name)
+			}
+		}
+		if len(missing) > 0 {
+			return newError("match не обрабатывает варианты %s перечисления %s", strings.Join(missing, ", "), enum.Name)
+		}
+	}
+	for _, arm := range node.Arms {
+		if arm.Variant != enumValue.Variant && arm.Variant != "_" {
+			continue
+		}
+		if arm.Variant != "_" && len(arm.Bindings) > 0 {
+			if len(arm.Bindings) != len(enumValue.Values) {
+				return newError("Вариант %s содержит значений: %d, но указано имён: %d",
+					arm.Variant, len(enumValue.Values), len(arm.Bindings))
+			}
+			for i, name := range arm.Bindings {
+				if name != "_" {
+					env.Set(name, enumValue.Values[i])
+				}
+			}
+		}
+		return ExecuteBlock(*arm.Body, env)
+	}
+	return NULL
+}
+
+func evalEnumBOExpression(operator lexer.TokenKind, left, right object.Object) object.Object {
+	leftVal, leftOk := left.(*object.EnumValue)
+	rightVal, rightOk := right.(*object.EnumValue)
+	if !leftOk || !rightOk {
+		return newError("Невозможно бинарное действие типов %s, %s", left.Type(), right.Type())
+	}
+	switch operator {
+	case lexer.EQUALS:
+		return nativeBoolToBooleanObject(enumValuesEqual(leftVal, rightVal))
+	case lexer.NOT_EQUALS:
+		return nativeBoolToBooleanObject(!enumValuesEqual(leftVal, rightVal))
+	}
+	return newError("Неизвестный оператор")
+}
+
+func enumValuesEqual(left, right *object.EnumValue) bool {
+	if left.Enum != right.Enum || left.Variant != right.Variant || len(left.Values) != len(right.Values) {
+		return false
+	}
+	for i := range left.Values {
+		if evaluateBOExpression(lexer.EQUALS, left.Values[i], right.Values[i]) != TRUE {
+			return false
+		}
+	}
+	return true
+}
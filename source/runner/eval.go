@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"meow/source/ast"
+	"meow/source/checker"
 	"meow/source/lexer"
 	"meow/source/parser"
 	"meow/source/runner/object"
@@ -15,49 +16,13 @@ func Evaluate(node ast.Expression, env *object.Environment) object.Object {
 	case *ast.ClassInstance:
 		return evaluateClassInstance(node, env)
 	case *ast.MemberInstance:
-		var inst string
-		member := node.MemberName
-		switch instance := node.Instance.(type) {
-		case *ast.SymbolExpression:
-			inst = instance.Value
-		case *ast.FunctionInstance:
-			returnVal := Evaluate(instance, env)
-			if returnVal.(*object.ReturnValue).Values[0].Type() != object.CLASS {
-				return newError("Функция не возвращает обьект класса")
-			} else {
-				mem := node.MemberName.(*ast.SymbolExpression).Value
-				return returnVal.(*object.ReturnValue).Values[0].(*object.Class).Fields[mem]
-			}
-
-		}
-		value := evaluateMemberInstance(inst, member, env)
-		return value
+		return evaluateMember(node, env)
 	case *ast.AssignmentExpression:
 		value := Evaluate(node.Value, env)
 		if IsError(value) {
 			return value
 		}
-		switch assigne := node.Assigne.(type) {
-		case *ast.SymbolExpression:
-			env.Set(assigne.Value, value)
-			return value
-		case *ast.MemberInstance:
-			parent := assigne.Instance.(*ast.SymbolExpression).Value
-			member := assigne.MemberName.(*ast.SymbolExpression).Value
-			class, ok := env.Get(parent)
-			if !ok {
-				return newError(fmt.Sprintf("Переменная '%s' не найдена в окружении", parent))
-			}
-			if class.Type() != object.CLASS {
-				return newError(fmt.Sprintf("Объект '%s' не является экземпляром класса", parent))
-			}
-			class.(*object.Class).Fields[member] = value
-			env.Set(parent, class)
-			return value
-
-		default:
-			return &object.Error{Message: fmt.Sprintf("Невозможно записать в тип: %T", assigne)}
-		}
+		return assignValue(node.Assigne, value, env)
 	case *ast.NumberExpression:
 		if isWhole(node.Value) {
 			return &object.Integer{Value: int64(node.Value)}
@@ -66,104 +31,84 @@ func Evaluate(node ast.Expression, env *object.Environment) object.Object {
 		}
 	case *ast.BooleanExpression:
 		return nativeBoolToBooleanObject(node.Value)
+	case *ast.NullExpression:
+		return NULL
 	case *ast.StringExpression:
 		return &object.String{Value: node.Value}
 	case *ast.PrefixExpression:
 		right := Evaluate(node.RightExpr, env)
+		if IsError(right) {
+			return right
+		}
 		return evaluatePrefixExpression(node, right)
 	case *ast.BOExpression:
 		left := Evaluate(node.Left, env)
 		if IsError(left) {
 			return left
 		}
+		if node.Op.Kind == lexer.NULL_COALESCING {
+			if left.Type() != object.NULL {
+				return left
+			}
+			return Evaluate(node.Right, env)
+		}
+		if left.Type() == object.BOOLEAN {
+			if node.Op.Kind == lexer.AND && !isTruthy(left) {
+				return FALSE
+			}
+			if node.Op.Kind == lexer.OR && isTruthy(left) {
+				return TRUE
+			}
+		}
 		right := Evaluate(node.Right, env)
 		if IsError(right) {
 			return right
 		}
 		return evaluateBOExpression(node.Op.Kind, left, right)
+	case *ast.IsExpression:
+		value := Evaluate(node.Value, env)
+		if IsError(value) {
+			return value
+		}
+		return nativeBoolToBooleanObject(checkParamType(value, node.Type))
 	case *ast.SymbolExpression:
 		return evaluateSymbolExpression(node, env)
 	case *ast.FunctionInstance:
-		defaults := checkForDefault(node.FunctionName)
-		if defaults {
-			switch node.FunctionName {
-			case "typeof":
-				arg := Evaluate(node.Parameters[0], env)
-				return &object.String{Value: []rune(arg.Type())}
-			case "string":
-				arg := Evaluate(node.Parameters[0], env)
-				if arg.Type() == object.FLOAT || arg.Type() == object.INTEGER {
-					return &object.String{Value: []rune(arg.Inspect())}
-				}
-				return newError("Невозможно привести тип данных %s к строке", arg.Type())
-			case "meow":
-				args := EvaluateExpressions(node.Parameters, env)
-				if len(args) == 1 {
-					fmt.Println(args[0].Inspect())
-					return nil
-				}
-				for _, arg := range args {
-					if IsError(arg) {
-						return arg
-					}
-					fmt.Print(arg.Inspect())
-				}
-				return nil
-			case "len":
-				value := Evaluate(node.Parameters[0], env)
-				switch val := value.(type) {
-				case *object.String:
-					return &object.Integer{Value: int64(len(val.Value))}
-				case *object.Array:
-					return &object.Integer{Value: int64(len(val.Elements))}
-				default:
-					return newError("Невозможно высчитать длину типа %s", val.Type())
-
-				}
-			case "tail":
-				args := EvaluateExpressions(node.Parameters, env)
-				if len(args) != 2 {
-					return newError("Функция tail требует два аргумента")
-				}
-				if args[0].Type() != object.ARRAY {
-					return newError("Первый аргумент функции tail должен быть массивом")
-				}
-				arr := args[0].(*object.Array)
-				if args[1].Type() != arr.ElementsType {
-					return newError("Второй аргумент функции tail должен быть %s", arr.ElementsType)
-				}
-				length := len(arr.Elements)
-				newElements := make([]object.Object, length+1)
-				copy(newElements, arr.Elements)
-				newElements[length] = args[1]
-				return &object.Array{Elements: newElements, ElementsType: arr.ElementsType}
-			}
+		if node.FunctionName == "super" {
+			return evaluateSuperConstructor(node, env)
 		}
 		functionObject, ok := env.Get(node.FunctionName)
 		if !ok {
-			return newError("Неизвестная функция: %s", node.FunctionName)
-		}
-		args := EvaluateExpressions(node.Parameters, env)
-		params := functionObject.(*object.FunctionLiteral).Parameters
-		if len(args) != len(params) {
-			return newError("Неверное число аргументов для функции %s. Ожидается %d, но получено %d",
-				node.FunctionName, len(params), len(args))
-		}
-		for i, arg := range args {
-			if arg.Type() == object.CLASS {
-				class := arg.(*object.Class)
-				if class.Name != params[i].Type.(*ast.SymbolType).Name {
-					return newError("Неверный объект %s для параметра %s", class.Name, params[i].Type.(*ast.SymbolType).Name)
-				}
-			} else if !checkTypes(arg, params[i].Type.(*ast.SymbolType).Name) {
-				return newError("Неверный аргумент %s для параметра %s.", arg.Inspect(), params[i].Type.(*ast.SymbolType).Name)
+			builtin, exists := builtins[node.FunctionName]
+			if !exists {
+				return newError("Неизвестная функция: %s", node.FunctionName)
 			}
+			functionObject = builtin
+		}
+		if builtin, ok := functionObject.(*object.Builtin); ok {
+			return evaluateBuiltinCall(builtin, node, env)
+		}
+		function, ok := functionObject.(*object.FunctionLiteral)
+		if !ok {
+			return newError("%s не является функцией", node.FunctionName)
+		}
+		args, err := bindArguments(function, node, env, nil)
+		if err != nil {
+			return err
 		}
 		return applyFunction(functionObject, args)
 	case *ast.ArrayDeclaration:
+		if err := env.Runtime().Allocate(); err != nil {
+			return err
+		}
 		elements := EvaluateExpressions(node.Elements, env)
-		if len(elements) == 1 && IsError(elements[0]) {
-			return elements[0]
+		for _, elem := range elements {
+			if IsError(elem) {
+				return elem
+			}
+		}
+		if len(elements) == 0 {
+			return &object.Array{Elements: elements}
 		}
 		_type := elements[0].Type()
 		for _, elem := range elements {
@@ -172,6 +117,8 @@ func Evaluate(node ast.Expression, env *object.Environment) object.Object {
 			}
 		}
 		return &object.Array{Elements: elements, ElementsType: _type}
+	case *ast.MapDeclaration:
+		return evaluateMapDeclaration(node, env)
 	case *ast.ArrayInstance:
 		left := Evaluate(node.Underlying, env)
 		if IsError(left) {
@@ -186,11 +133,124 @@ func Evaluate(node ast.Expression, env *object.Environment) object.Object {
 	return NULL
 }
 
-func evaluateMemberInstance(instance string, member ast.Expression, env *object.Environment) object.Object {
-	instanceVal, ok := env.Get(instance)
-	if !ok {
-		return newError("Объект %s не найден", instance)
+func assignValue(assigne ast.Expression, value object.Object, env *object.Environment) object.Object {
+	switch assigne := assigne.(type) {
+	case *ast.SymbolExpression:
+		if assigne.Value == "_" {
+			return value
+		}
+		env.Set(assigne.Value, value)
+		return value
+	case *ast.MemberInstance:
+		member := assigne.MemberName.(*ast.SymbolExpression).Value
+		var class object.Object
+		if symbol, ok := assigne.Instance.(*ast.SymbolExpression); ok {
+			parent := symbol.Value
+			class, ok = env.Get(parent)
+			if !ok {
+				return newError(fmt.Sprintf("Переменная '%s' не найдена в окружении", parent))
+			}
+			if class.Type() != object.CLASS {
+				return newError(fmt.Sprintf("Объект '%s' не является экземпляром класса", parent))
+			}
+		} else {
+			// o.inner.x = 5: сначала вычисляем o.inner
+			class = Evaluate(assigne.Instance, env)
+			if IsError(class) {
+				return class
+			}
+			if class.Type() != object.CLASS {
+				return newError("Значение типа %s не является экземпляром класса", class.Type())
+			}
+		}
+		instance := class.(*object.Class)
+		if instance.Class == nil {
+			owner := findStaticField(instance, member)
+			if owner == nil {
+				return newError("Поле %s не является статическим полем класса %s", member, instance.Name)
+			}
+			if owner.Private[member] && env.ClassName() != owner.Name {
+				return newError("Член %s класса %s является приватным", member, owner.Name)
+			}
+			if !checkParamType(value, owner.Declarations[member].Type) {
+				return newError("Невозможно присвоить полю %s класса %s неверного типа", member, owner.Name)
+			}
+			owner.StaticFields[member] = value
+			return value
+		}
+		if owner := findStaticField(instance.Class, member); owner != nil {
+			return newError("Статическое поле %s доступно только через класс %s", member, owner.Name)
+		}
+		if owner := privateOwner(instance.Class, member); owner != nil && env.ClassName() != owner.Name {
+			return newError("Член %s класса %s является приватным", member, owner.Name)
+		}
+		declaration, ok := instance.Class.Declarations[member]
+		if !ok {
+			return newError("Поле %s не найдено в классе %s", member, instance.Name)
+		}
+		if !matchType(value, declaration.Type, instance.TypeArguments) {
+			return newError("Невозможно присвоить полю %s объекта %s неверного типа", member, instance.Name)
+		}
+		instance.Fields[member] = value
+		return value
+	case *ast.ArrayInstance:
+		left := Evaluate(assigne.Underlying, env)
+		if IsError(left) {
+			return left
+		}
+		index := Evaluate(assigne.Content[0], env)
+		if IsError(index) {
+			return index
+		}
+		return assignIndex(left, index, value)
+
+	default:
+		return &object.Error{Message: fmt.Sprintf("Невозможно записать в тип: %T", assigne)}
+	}
+}
+
+// expandTuples раскрывает кортежи, возвращённые функциями, в плоский список значений.
+func expandTuples(values []object.Object) []object.Object {
+	expanded := make([]object.Object, 0, len(values))
+	for _, value := range values {
+		if tuple, ok := value.(*object.Tuple); ok {
+			expanded = append(expanded, tuple.Elements...)
+			continue
+		}
+		expanded = append(expanded, value)
 	}
+	return expanded
+}
+
+// evaluateMember вычисляет объект слева от точки и обращается к его члену.
+// Слева может стоять переменная или любое выражение: a.b, f().b, a.b.c.
+func evaluateMember(node *ast.MemberInstance, env *object.Environment) object.Object {
+	var instance string
+	var instanceVal object.Object
+	if symbol, ok := node.Instance.(*ast.SymbolExpression); ok {
+		instance = symbol.Value
+		value, ok := env.Get(instance)
+		if !ok {
+			return newError("Объект %s не найден", instance)
+		}
+		instanceVal = value
+	} else {
+		instanceVal = Evaluate(node.Instance, env)
+		if IsError(instanceVal) {
+			return instanceVal
+		}
+	}
+	if instanceVal.Type() == object.NULL {
+		if node.IsOptional {
+			return NULL
+		}
+		return newError("Обращение к члену объекта, равного null")
+	}
+	return evaluateMemberInstance(instanceVal, node.MemberName, env)
+}
+
+// evaluateMemberInstance обращается к члену объекта instanceVal.
+func evaluateMemberInstance(instanceVal object.Object, member ast.Expression, env *object.Environment) object.Object {
 	var memberName string
 	switch member := member.(type) {
 	case *ast.SymbolExpression:
@@ -201,30 +261,72 @@ func evaluateMemberInstance(instance string, member ast.Expression, env *object.
 	switch instanceVal.Type() {
 	case object.CLASS:
 		class := instanceVal.(*object.Class)
+		if class.Class == nil {
+			return evaluateStaticMember(class, memberName, member, env)
+		}
+		if owner := privateOwner(class.Class, memberName); owner != nil && env.ClassName() != owner.Name {
+			return newError("Член %s класса %s является приватным", memberName, owner.Name)
+		}
 		field, ok := class.Fields[memberName]
 		if !ok {
 			function, ok := class.Functions[memberName]
 			if !ok {
+				if findStaticField(class.Class, memberName) != nil || findStaticFunction(class.Class, memberName) != nil {
+					return newError("Статический член %s доступен только через класс %s", memberName, class.Name)
+				}
 				return newError("Функция %s не найдена в классе %s", memberName, class.Name)
 			}
-			params := EvaluateExpressions(member.(*ast.FunctionInstance).Parameters, env)
+			call, ok := member.(*ast.FunctionInstance)
+			if !ok {
+				return bindMethod(class, function.(*object.FunctionLiteral))
+			}
+			params, err := bindArguments(function.(*object.FunctionLiteral), call, env, instanceVal)
+			if err != nil {
+				return err
+			}
 			params = append([]object.Object{instanceVal}, params...)
-			actualClass, _ := env.Get(class.Name)
-			env.Set(class.Name, instanceVal)
-			result := applyFunction(function, params)
-			env.Set(class.Name, actualClass)
-
-			return result
+			return applyFunction(function, params)
 		}
 		return field
+	case object.ENUM:
+		return evaluateEnumMember(instanceVal.(*object.Enum), memberName, member, env)
+	case object.SUPER:
+		super := instanceVal.(*object.Super)
+		call, ok := member.(*ast.FunctionInstance)
+		if !ok {
+			field, ok := super.Instance.Fields[memberName]
+			if !ok {
+				return newError("Поле %s не найдено в классе %s", memberName, super.Instance.Name)
+			}
+			return field
+		}
+		function, ok := super.Class.Functions[memberName]
+		if !ok {
+			return newError("Функция %s не найдена в родительском классе %s", memberName, super.Class.Name)
+		}
+		params, err := bindArguments(function.(*object.FunctionLiteral), call, env, super.Instance)
+		if err != nil {
+			return err
+		}
+		return applyFunction(function, append([]object.Object{super.Instance}, params...))
 	case object.MODULE:
 		module := instanceVal.(*object.Module)
 		field, ok := module.Environment.Get(memberName)
-		if !ok {
+		if !ok || module.Environment.IsPrivate(memberName) {
 			return newError(" %s не найдено в модуле %s", memberName, module.Name)
 		}
-		if field.Type() == object.FUNCTION {
-			params := EvaluateExpressions(member.(*ast.FunctionInstance).Parameters, env)
+		if builtin, ok := field.(*object.Builtin); ok {
+			if call, ok := member.(*ast.FunctionInstance); ok {
+				return evaluateBuiltinCall(builtin, call, env)
+			}
+			return builtin
+		}
+		call, ok := member.(*ast.FunctionInstance)
+		if field.Type() == object.FUNCTION && ok {
+			params, err := bindArguments(field.(*object.FunctionLiteral), call, env, nil)
+			if err != nil {
+				return err
+			}
 			result := applyFunction(field, params)
 			return result
 		}
@@ -236,44 +338,225 @@ func evaluateMemberInstance(instance string, member ast.Expression, env *object.
 func evaluateClassInstance(node *ast.ClassInstance, env *object.Environment) object.Object {
 	parentClass, ok := env.Get(node.ClassName)
 	if !ok {
-		return newError("Класс %s не найден", node.ClassName)
+		builtin, exists := builtinClasses[node.ClassName]
+		if !exists {
+			return newError("Класс %s не найден", node.ClassName)
+		}
+		parentClass = builtin
 	}
 	if parentClass.Type() != object.CLASS {
 		return newError("Неверный класс родитель %s", node.ClassName)
 	}
 	class := parentClass.(*object.Class)
-	var fields = make(map[string]object.Object)
+	if class.Class != nil {
+		return newError("%s не является классом", node.ClassName)
+	}
+	typeArguments, err := newTypeArguments(class, node.TypeArguments)
+	if err != nil {
+		return err
+	}
+	if err := env.Runtime().Allocate(); err != nil {
+		return err
+	}
+	if class.Constructor != nil {
+		return constructClassInstance(node, class, typeArguments, env)
+	}
+	if len(node.Parameters) > 0 {
+		return newError("Класс %s не имеет конструктора, поля указываются по имени", class.Name)
+	}
+	fields, err := newFieldValues(class, typeArguments)
+	if err != nil {
+		return err
+	}
 	for index, expr := range node.Fields {
-		if expr == nil {
-			continue
+		declaration, ok := class.Declarations[index]
+		if !ok || declaration.IsStatic {
+			return newError("Поле %s не найдено в классе %s", index, class.Name)
 		}
 		value := Evaluate(expr, env)
 		if IsError(value) {
 			return value
 		}
-		if class.Fields[index].Type() != value.Type() {
+		if !matchType(value, declaration.Type, typeArguments) {
 			return newError("Невозможно присвоить полю %s объекта %s неверного типа", index, class.Name)
 		}
 		fields[index] = value
 	}
+	instance := newInstance(class, fields)
+	instance.TypeArguments = typeArguments
+	return instance
+
+}
+
+// bindMethod возвращает метод, привязанный к экземпляру: var g = p.greet;
+// его можно вызвать или передать в map и filter.
+func bindMethod(instance *object.Class, method *object.FunctionLiteral) *object.Builtin {
+	return &object.Builtin{
+		Name:  method.Name,
+		Arity: -1,
+		Fn: func(env *object.Environment, args ...object.Object) object.Object {
+			params, err := bindValues(method, method.Name, args, nil, instance)
+			if err != nil {
+				return err
+			}
+			return applyFunction(method, append([]object.Object{instance}, params...))
+		},
+	}
+}
+
+// evaluateStaticMember обращается к статическому полю или методу
+// через имя класса: Person.count, Person.create(...).
+func evaluateStaticMember(class *object.Class, memberName string, member ast.Expression, env *object.Environment) object.Object {
+	if owner := findStaticField(class, memberName); owner != nil {
+		if owner.Private[memberName] && env.ClassName() != owner.Name {
+			return newError("Член %s класса %s является приватным", memberName, owner.Name)
+		}
+		return owner.StaticFields[memberName]
+	}
+	owner := findStaticFunction(class, memberName)
+	if owner != nil && owner.Private[memberName] && env.ClassName() != owner.Name {
+		return newError("Член %s класса %s является приватным", memberName, owner.Name)
+	}
+	if owner == nil {
+		if _, ok := class.Fields[memberName]; ok {
+			return newError("Поле %s не является статическим полем класса %s", memberName, class.Name)
+		}
+		if _, ok := class.Functions[memberName]; ok {
+			return newError("Метод %s не является статическим методом класса %s", memberName, class.Name)
+		}
+		return newError("Статический член %s не найден в классе %s", memberName, class.Name)
+	}
+	function := owner.StaticFunctions[memberName]
+	call, ok := member.(*ast.FunctionInstance)
+	if !ok {
+		return function
+	}
+	args, err := bindArguments(function.(*object.FunctionLiteral), call, env, nil)
+	if err != nil {
+		return err
+	}
+	return applyFunction(function, args)
+}
+
+// findStaticField ищет класс в цепочке наследования, который хранит статическое поле.
+func findStaticField(class *object.Class, name string) *object.Class {
+	for c := class; c != nil; c = c.Parent {
+		if _, ok := c.StaticFields[name]; ok {
+			return c
+		}
+	}
+	return nil
+}
+
+func findStaticFunction(class *object.Class, name string) *object.Class {
+	for c := class; c != nil; c = c.Parent {
+		if _, ok := c.StaticFunctions[name]; ok {
+			return c
+		}
+	}
+	return nil
+}
+
+// newInstance создаёт объект класса с заданными полями.
+func newInstance(class *object.Class, fields map[string]object.Object) *object.Class {
 	return &object.Class{
-		Name:      node.ClassName,
-		Fields:    fields,
-		Functions: class.Functions,
+		Name:        class.Name,
+		Class:       class,
+		Parent:      class.Parent,
+		Interfaces:  class.Interfaces,
+		Fields:      fields,
+		Functions:   class.Functions,
+		Constructor: class.Constructor,
 	}
+}
 
+// evaluateSuperConstructor вызывает конструктор родительского класса
+// для текущего объекта: super(...) внутри конструктора наследника.
+func evaluateSuperConstructor(node *ast.FunctionInstance, env *object.Environment) object.Object {
+	value, ok := env.Get("super")
+	if !ok || value.Type() != object.SUPER {
+		return newError("super можно вызвать только в методе наследника")
+	}
+	super := value.(*object.Super)
+	if super.Class.Constructor == nil {
+		return newError("Класс %s не имеет конструктора", super.Class.Name)
+	}
+	args, err := bindArguments(super.Class.Constructor, node, env, super.Instance)
+	if err != nil {
+		return err
+	}
+	result := applyFunction(super.Class.Constructor, append([]object.Object{super.Instance}, args...))
+	if IsError(result) {
+		return result
+	}
+	return NULL
+}
+
+// constructClassInstance создаёт объект и передаёт аргументы ^^Class(...)
+// в конструктор класса.
+func constructClassInstance(node *ast.ClassInstance, class *object.Class, typeArguments map[string]ast.Type, env *object.Environment) object.Object {
+	fields, err := newFieldValues(class, typeArguments)
+	if err != nil {
+		return err
+	}
+	instance := newInstance(class, fields)
+	instance.TypeArguments = typeArguments
+	call := &ast.FunctionInstance{
+		FunctionName:    class.Name,
+		Parameters:      node.Parameters,
+		NamedParameters: node.Fields,
+	}
+	args, bindErr := bindArguments(class.Constructor, call, env, instance)
+	if bindErr != nil {
+		return bindErr
+	}
+	result := applyFunction(class.Constructor, append([]object.Object{instance}, args...))
+	if IsError(result) {
+		return result
+	}
+	return instance
 }
 
 func evalIndexExpression(left, index object.Object) object.Object {
+	if instance, fn := findMethod(left, indexMethod); fn != nil {
+		return callSpecialMethod(instance, fn, indexMethod, index)
+	}
 	switch {
 	case left.Type() == object.ARRAY && index.Type() == object.INTEGER:
 		return evalArrayIndexExpression(left, index)
 	case left.Type() == object.STRING && index.Type() == object.INTEGER:
 		return evalStringIndexExpression(left, index)
+	case left.Type() == object.MAP:
+		return evalMapIndexExpression(left, index)
 	}
 	return newError("Невозможно получить доступ по индексу для объекта %s", left.Type())
 }
 
+// assignIndex записывает значение в элемент массива или словаря: a[i] = v.
+func assignIndex(left, index, value object.Object) object.Object {
+	switch left := left.(type) {
+	case *object.Map:
+		if err := setMapValue(left, index, value); err != nil {
+			return err
+		}
+		return value
+	case *object.Array:
+		position, ok := index.(*object.Integer)
+		if !ok {
+			return newError("Индекс массива должен быть int, получено %s", index.Type())
+		}
+		if position.Value < 0 || position.Value >= int64(len(left.Elements)) {
+			return newError("Индекс выходит за границы массива")
+		}
+		if left.ElementsType != "" && value.Type() != left.ElementsType {
+			return newError("Элемент массива должен быть %s, получено %s", left.ElementsType, value.Type())
+		}
+		left.Elements[position.Value] = value
+		return value
+	}
+	return newError("Невозможно записать по индексу в объект %s", left.Type())
+}
+
 func evalArrayIndexExpression(array object.Object, index object.Object) object.Object {
 	indexValue := index.(*object.Integer).Value
 	arrayValue := array.(*object.Array)
@@ -297,19 +580,130 @@ func applyFunction(fn object.Object, args []object.Object) object.Object {
 	if !ok {
 		return newError("Не является функцией")
 	}
+	runtime := function.Env.Runtime()
+	if err := runtime.Enter(); err != nil {
+		err.Stack = append(err.Stack, function.Name)
+		return err
+	}
+	defer runtime.Leave()
 	extendedEnv := extendFunctionEnv(function, args)
+	extendedEnv.SetClassName(function.ClassName)
 	if function.IsMethod {
 		classInstance, ok := args[0].(*object.Class)
 		if !ok {
 			return newError("Метод должен быть вызван с объектом")
 		}
 		extendedEnv.Set("this", classInstance)
+		if function.SuperClass != nil {
+			extendedEnv.Set("super", &object.Super{Instance: classInstance, Class: function.SuperClass})
+		}
 	}
 	executed := Execute(function.Body, extendedEnv)
-	if IsError(executed) {
-		return executed
+	if err, ok := executed.(*object.Error); ok {
+		err.Stack = append(err.Stack, function.Name)
+		return err
+	}
+	return unwrapReturn(executed, function.ReturnType, inferTypeBindings(function, args))
+}
+
+// bindArguments сопоставляет аргументы вызова с параметрами функции:
+// сначала позиционные, затем именованные, недостающие берутся из значений
+// по умолчанию, а остаток собирается в массив вариативного параметра.
+// instance — объект, у которого вызывается метод, или nil.
+func bindArguments(fn *object.FunctionLiteral, node *ast.FunctionInstance, env *object.Environment, instance object.Object) ([]object.Object, object.Object) {
+	args := EvaluateExpressions(node.Parameters, env)
+	for _, arg := range args {
+		if IsError(arg) {
+			return nil, arg
+		}
+	}
+	named := make(map[string]object.Object, len(node.NamedParameters))
+	for name, expr := range node.NamedParameters {
+		value := Evaluate(expr, env)
+		if IsError(value) {
+			return nil, value
+		}
+		named[name] = value
+	}
+	return bindValues(fn, node.FunctionName, args, named, instance)
+}
+
+// bindValues сопоставляет уже вычисленные аргументы с параметрами функции.
+func bindValues(fn *object.FunctionLiteral, fnName string, args []object.Object, named map[string]object.Object, instance object.Object) ([]object.Object, object.Object) {
+	params := fn.Parameters
+	bindings := newTypeBindings(fn.TypeParameters, instance)
+	bound := make([]object.Object, len(params))
+	variadic := len(params) > 0 && params[len(params)-1].IsVariadic
+	fixed := len(params)
+	if variadic {
+		fixed--
 	}
-	return unwrapReturn(executed, function.ReturnType)
+	if len(args) > fixed && !variadic {
+		return nil, newError("Неверное число аргументов для функции %s. Ожидается %d, но получено %d",
+			fnName, len(params), len(args))
+	}
+	for i := 0; i < len(args) && i < fixed; i++ {
+		bound[i] = args[i]
+	}
+	if variadic {
+		rest := []object.Object{}
+		if len(args) > fixed {
+			rest = args[fixed:]
+		}
+		elementsType := params[fixed].Type
+		for _, arg := range rest {
+			if !matchType(arg, elementsType, bindings) {
+				return nil, newError("Неверный аргумент %s для параметра %s.", arg.Inspect(), typeName(substituteType(elementsType, bindings)))
+			}
+		}
+		array := &object.Array{Elements: rest, ElementsType: objectTypeOf(substituteType(elementsType, bindings))}
+		if len(rest) > 0 {
+			array.ElementsType = rest[0].Type()
+		}
+		bound[fixed] = array
+	}
+	for name, value := range named {
+		index := -1
+		for i, param := range params {
+			if param.Names[0] == name {
+				index = i
+				break
+			}
+		}
+		if index == -1 {
+			return nil, newError("Функция %s не имеет параметра %s", fnName, name)
+		}
+		if params[index].IsVariadic {
+			return nil, newError("Вариативный параметр %s не может быть передан по имени", name)
+		}
+		if bound[index] != nil {
+			return nil, newError("Параметр %s функции %s передан дважды", name, fnName)
+		}
+		bound[index] = value
+	}
+	defaultsEnv := object.NewEnclosedEnvironment(fn.Env)
+	for i, param := range params {
+		if bound[i] == nil {
+			if param.AssignedValue == nil {
+				return nil, newError("Не передан аргумент %s функции %s", param.Names[0], fnName)
+			}
+			value := Evaluate(param.AssignedValue, defaultsEnv)
+			if IsError(value) {
+				return nil, value
+			}
+			bound[i] = value
+		}
+		if !param.IsVariadic && !matchType(bound[i], param.Type, bindings) {
+			expected := typeName(substituteType(param.Type, bindings))
+			if bound[i].Type() == object.CLASS {
+				return nil, newError("Неверный объект %s для параметра %s", bound[i].(*object.Class).Name, expected)
+			}
+			return nil, newError("Неверный аргумент %s для параметра %s.", bound[i].Inspect(), expected)
+		}
+		defaultsEnv.Set(param.Names[0], bound[i])
+	}
+	bindings.saveTo(instance)
+	return bound, nil
 }
 
 func extendFunctionEnv(fn *object.FunctionLiteral, args []object.Object) *object.Environment {
@@ -327,7 +721,7 @@ func extendFunctionEnv(fn *object.FunctionLiteral, args []object.Object) *object
 	return env
 }
 
-func unwrapReturn(obj object.Object, _types []object.ObjectType) object.Object {
+func unwrapReturn(obj object.Object, _types []ast.Type, bindings typeBindings) object.Object {
 	if len(_types) == 0 {
 		return NULL
 	}
@@ -336,11 +730,14 @@ func unwrapReturn(obj object.Object, _types []object.ObjectType) object.Object {
 			return newError("Ожидалось к возврату: %d. Получено : %d", len(_types), len(returnValue.Values))
 		}
 		for i := 0; i < len(returnValue.Values); i++ {
-			if returnValue.Values[i].Type() != _types[i] {
-				return newError("Невозможно привести возвращаемое значение к %s", _types[i])
+			if !matchType(returnValue.Values[i], _types[i], bindings) {
+				return newError("Невозможно привести возвращаемое значение к %s", typeName(substituteType(_types[i], bindings)))
 			}
 		}
-		return returnValue.Values[0]
+		if len(returnValue.Values) == 1 {
+			return returnValue.Values[0]
+		}
+		return &object.Tuple{Elements: returnValue.Values}
 	}
 	return newError("Функция ничего не возвращает")
 }
@@ -365,6 +762,9 @@ func evaluatePrefixExpression(node *ast.PrefixExpression, right object.Object) o
 func evaluateSymbolExpression(node *ast.SymbolExpression, env *object.Environment) object.Object {
 	value, ok := env.Get(node.Value)
 	if !ok {
+		if builtin, exists := builtins[node.Value]; exists {
+			return builtin
+		}
 		return newError("Неизвестная переменная: %s", node.Value)
 	}
 	return value
@@ -372,6 +772,10 @@ func evaluateSymbolExpression(node *ast.SymbolExpression, env *object.Environmen
 
 func evaluateBOExpression(operator lexer.TokenKind, left, right object.Object) object.Object {
 	switch {
+	case left.Type() == object.NULL || right.Type() == object.NULL:
+		return evalNullBOExpression(operator, left, right)
+	case left.Type() == object.CLASS || right.Type() == object.CLASS:
+		return evalClassBOExpression(operator, left, right)
 	case left.Type() == object.FLOAT && right.Type() == object.FLOAT:
 		return evalFloatBOExpression(operator, left, right)
 	case left.Type() == object.INTEGER && right.Type() == object.INTEGER:
@@ -382,11 +786,26 @@ func evaluateBOExpression(operator lexer.TokenKind, left, right object.Object) o
 		return evalFloatIntegerBOExpression(operator, left, right)
 	case left.Type() == object.STRING && right.Type() == object.STRING:
 		return evalStringBOExpression(operator, left, right)
+	case left.Type() == object.BOOLEAN && right.Type() == object.BOOLEAN:
+		return evalBooleanBOExpression(operator, left, right)
+	case left.Type() == object.ENUM_VALUE || right.Type() == object.ENUM_VALUE:
+		return evalEnumBOExpression(operator, left, right)
 	}
 	return newError("Невозможно бинарное действие типов %s, %s", left.Type(), right.Type())
 
 }
 
+// evalNullBOExpression сравнивает значение с null; другие действия с null недопустимы.
+func evalNullBOExpression(operator lexer.TokenKind, left, right object.Object) object.Object {
+	switch operator {
+	case lexer.EQUALS:
+		return nativeBoolToBooleanObject(left == right)
+	case lexer.NOT_EQUALS:
+		return nativeBoolToBooleanObject(left != right)
+	}
+	return newError("Невозможно бинарное действие типов %s, %s", left.Type(), right.Type())
+}
+
 func evalFloatIntegerBOExpression(operator lexer.TokenKind, left, right object.Object) object.Object {
 	var leftVal float64
 	var rightVal float64
@@ -513,6 +932,22 @@ func evalStringBOExpression(operator lexer.TokenKind, left, right object.Object)
 	return newError("Неизвестный оператор")
 }
 
+func evalBooleanBOExpression(operator lexer.TokenKind, left, right object.Object) object.Object {
+	leftVal := left.(*object.Boolean).Value
+	rightVal := right.(*object.Boolean).Value
+	switch operator {
+	case lexer.AND:
+		return nativeBoolToBooleanObject(leftVal && rightVal)
+	case lexer.OR:
+		return nativeBoolToBooleanObject(leftVal || rightVal)
+	case lexer.EQUALS:
+		return nativeBoolToBooleanObject(leftVal == rightVal)
+	case lexer.NOT_EQUALS:
+		return nativeBoolToBooleanObject(leftVal != rightVal)
+	}
+	return newError("Неизвестный оператор")
+}
+
 func evalMinusOperatorExpr(right object.Object) object.Object {
 	switch r := right.(type) {
 	case *object.Integer:
@@ -525,11 +960,35 @@ func evalMinusOperatorExpr(right object.Object) object.Object {
 }
 
 func Execute(node ast.Statement, env *object.Environment) object.Object {
+	if err := env.Runtime().Step(); err != nil {
+		return err
+	}
 	switch node := node.(type) {
 	case *ast.ImportStatement:
 		return ExecuteImportStat(*node, env)
+	case *ast.ThrowStatement:
+		return ExecuteThrow(*node, env)
+	case *ast.TryStatement:
+		return ExecuteTry(*node, env)
 	case *ast.ClassDecStatement:
 		return ExecuteClassDec(*node, env)
+	case *ast.InterfaceDecStatement:
+		return ExecuteInterfaceDec(*node, env)
+	case *ast.EnumDecStatement:
+		return ExecuteEnumDec(*node, env)
+	case *ast.MatchStatement:
+		return ExecuteMatch(*node, env)
+	case *ast.VisibilityStatement:
+		result := Execute(node.Statement, env)
+		if IsError(result) {
+			return result
+		}
+		if node.IsPrivate {
+			for _, name := range declaredNames(node.Statement) {
+				env.MarkPrivate(name)
+			}
+		}
+		return result
 	case *ast.ExpressionStatement:
 		return Evaluate(node.Expression, env)
 	case *ast.BlockStatement:
@@ -538,49 +997,80 @@ func Execute(node ast.Statement, env *object.Environment) object.Object {
 		return EvaluateIf(*node, env)
 	case *ast.ReturnStatement:
 		val := EvaluateExpressions(node.Expressions, env)
-		return &object.ReturnValue{Values: val}
+		for _, v := range val {
+			if IsError(v) {
+				return v
+			}
+		}
+		return &object.ReturnValue{Values: expandTuples(val)}
 	case *ast.VariableDecStatement:
 		val := Evaluate(node.AssignedValue, env)
-		if val.Type() == object.RETURN_VALUE {
-			for i := 0; i < len(val.(*object.ReturnValue).Values); i++ {
-				env.Set(node.Names[i], val.(*object.ReturnValue).Values[i])
+		if IsError(val) {
+			return val
+		}
+		values := []object.Object{val}
+		if tuple, ok := val.(*object.Tuple); ok {
+			values = tuple.Elements
+		}
+		if len(values) != len(node.Names) {
+			return newError("Невозможно присвоить %d значений %d переменным", len(values), len(node.Names))
+		}
+		for i, name := range node.Names {
+			if name == "_" {
+				continue
+			}
+			env.Set(name, values[i])
+		}
+	case *ast.MultiAssignmentStatement:
+		val := EvaluateExpressions(node.Values, env)
+		for _, v := range val {
+			if IsError(v) {
+				return v
+			}
+		}
+		values := expandTuples(val)
+		if len(values) != len(node.Assignees) {
+			return newError("Невозможно присвоить %d значений %d переменным", len(values), len(node.Assignees))
+		}
+		for i, assigne := range node.Assignees {
+			result := assignValue(assigne, values[i], env)
+			if IsError(result) {
+				return result
 			}
-		} else {
-			env.Set(node.Names[0], val)
 		}
 	case *ast.FunctionDecStatement:
 		params := node.Parameters
 		body := node.Body
-		var returnTypes []object.ObjectType
-		for _, _type := range node.ReturnType {
-			if _type.(*ast.SymbolType).Name == "string" {
-				returnTypes = append(returnTypes, object.STRING)
-			} else if _type.(*ast.SymbolType).Name == "bool" {
-				returnTypes = append(returnTypes, object.BOOLEAN)
-			} else if _type.(*ast.SymbolType).Name == "int" {
-				returnTypes = append(returnTypes, object.INTEGER)
-			} else if _type.(*ast.SymbolType).Name == "array" {
-				returnTypes = append(returnTypes, object.ARRAY)
-			} else if _type.(*ast.SymbolType).Name == "float" {
-				returnTypes = append(returnTypes, object.FLOAT)
-			} else {
-				returnTypes = append(returnTypes, object.CLASS)
-			}
-		}
 		function := &object.FunctionLiteral{
-			Env:        env,
-			Parameters: params,
-			Body:       body,
-			ReturnType: returnTypes,
+			Name:           node.Name,
+			TypeParameters: node.TypeParameters,
+			Env:            env,
+			Parameters:     params,
+			Body:           body,
+			ReturnType:     node.ReturnType,
 		}
 		functionFromEnv := env.Set(node.Name, function)
 		return functionFromEnv
 
 	case *ast.WhileStatement:
-		conditions := EvaluateExpressions(node.Conditions, env)
-		for isAllTruthy(conditions) {
-			ExecuteBlock(*node.Body, env)
-			conditions = EvaluateExpressions(node.Conditions, env)
+		for {
+			// Шаг на каждую итерацию, чтобы ограничения действовали и на пустой цикл.
+			if err := env.Runtime().Step(); err != nil {
+				return err
+			}
+			conditions := EvaluateExpressions(node.Conditions, env)
+			for _, condition := range conditions {
+				if IsError(condition) {
+					return condition
+				}
+			}
+			if !isAllTruthy(conditions) {
+				break
+			}
+			result := ExecuteBlock(*node.Body, env)
+			if result != nil && (result.Type() == object.RETURN_VALUE || result.Type() == object.ERROR) {
+				return result
+			}
 		}
 	}
 	return NULL
@@ -590,89 +1080,188 @@ func ExecuteClassDec(node ast.ClassDecStatement, env *object.Environment) object
 	className := node.Name
 	var variables = make(map[string]object.Object)
 	var functions = make(map[string]object.Object)
+	var staticVariables = make(map[string]object.Object)
+	var staticFunctions = make(map[string]object.Object)
+	var private = make(map[string]bool)
+	var declarations = make(map[string]ast.ClassFieldStatement)
+	var typeParameters []string
+	var parent *object.Class
+	if node.Parent != "" {
+		parentObject, ok := env.Get(node.Parent)
+		if !ok {
+			builtin, exists := builtinClasses[node.Parent]
+			if !exists {
+				return newError("Родительский класс %s не найден", node.Parent)
+			}
+			parentObject = builtin
+		}
+		parent, ok = parentObject.(*object.Class)
+		if !ok || parent.Class != nil {
+			return newError("%s не является классом", node.Parent)
+		}
+		for index, field := range parent.Fields {
+			variables[index] = field
+		}
+		for index, fn := range parent.Functions {
+			functions[index] = fn
+		}
+		for index := range parent.Private {
+			private[index] = true
+		}
+		for index, declaration := range parent.Declarations {
+			if !declaration.IsStatic {
+				declarations[index] = declaration
+			}
+		}
+		typeParameters = append(typeParameters, parent.TypeParameters...)
+	}
+	typeParameters = append(typeParameters, node.TypeParameters...)
+	bindings := newTypeBindings(typeParameters, nil)
 	for index, variable := range node.Fields {
-		tmp := EvaluateClassField(variable, env)
+		if _, exists := variables[index]; exists {
+			return newError("Поле %s уже объявлено в родительском классе %s", index, node.Parent)
+		}
+		tmp := EvaluateClassField(variable, env, bindings)
+		if IsError(tmp) {
+			return tmp
+		}
+		if variable.IsPrivate {
+			private[index] = true
+		}
+		declarations[index] = variable
+		if variable.IsStatic {
+			staticVariables[index] = tmp
+			continue
+		}
 		variables[index] = tmp
 	}
 	for index, fn := range node.Functions {
 		tmp := EvaluateFunctionField(className, fn, env, index)
+		if fn.IsPrivate {
+			private[index] = true
+		}
+		if fn.IsStatic {
+			tmp.IsMethod = false
+			staticFunctions[index] = tmp
+			continue
+		}
+		tmp.SuperClass = parent
 		functions[index] = tmp
 
 	}
 	class := &object.Class{
-		Name:      className,
-		Fields:    variables,
-		Functions: functions,
+		Name:            className,
+		TypeParameters:  typeParameters,
+		Env:             env,
+		Parent:          parent,
+		Fields:          variables,
+		Declarations:    declarations,
+		Functions:       functions,
+		StaticFields:    staticVariables,
+		StaticFunctions: staticFunctions,
+		Private:         private,
+	}
+	if node.Constructor != nil {
+		class.Constructor = EvaluateFunctionField(className, *node.Constructor, env, "constructor")
+		class.Constructor.SuperClass = parent
+	} else if parent != nil {
+		class.Constructor = parent.Constructor
+	}
+	for _, name := range node.Interfaces {
+		ifaceObject, ok := env.Get(name)
+		if !ok {
+			return newError("Интерфейс %s не найден", name)
+		}
+		iface, ok := ifaceObject.(*object.Interface)
+		if !ok {
+			return newError("%s не является интерфейсом", name)
+		}
+		if err := checkImplements(class, iface); err != nil {
+			return err
+		}
+		class.Interfaces = append(class.Interfaces, iface)
 	}
 	env.Set(className, class)
 	return class
 }
 
-func EvaluateClassField(variable ast.ClassFieldStatement, env *object.Environment) object.Object {
-	switch variable.Type.(type) {
-	case *ast.ArrayType:
-		return &object.Array{}
+func ExecuteInterfaceDec(node ast.InterfaceDecStatement, env *object.Environment) object.Object {
+	iface := &object.Interface{
+		Name:      node.Name,
+		Functions: node.Functions,
 	}
-	switch variable.Type.(*ast.SymbolType).Name {
-	case "string":
-		return &object.String{}
-	case "int":
-		return &object.Integer{}
-	case "bool":
-		return &object.Boolean{}
-	case "float":
-		return &object.Float{}
-	case "array":
-		return &object.Array{}
+	env.Set(node.Name, iface)
+	return iface
+}
+
+// checkImplements сверяет методы класса с сигнатурами интерфейса:
+// совпадать должны число и типы параметров и возвращаемых значений.
+func checkImplements(class *object.Class, iface *object.Interface) object.Object {
+	for name, signature := range iface.Functions {
+		fn, ok := class.Functions[name]
+		if !ok {
+			return newError("Класс %s не реализует метод %s интерфейса %s", class.Name, name, iface.Name)
+		}
+		method := fn.(*object.FunctionLiteral)
+		if !sameTypes(parameterTypes(method.Parameters), parameterTypes(signature.Parameters)) ||
+			!sameTypes(method.ReturnType, signature.ReturnTypes) {
+			return newError("Сигнатура метода %s класса %s не совпадает с интерфейсом %s", name, class.Name, iface.Name)
+		}
 	}
-	return newError("Неизвестный тип поля: %s", variable.Type.(*ast.SymbolType).Name)
+	return nil
 }
 
-func EvaluateFunctionField(className string, fn ast.ClassFunctionStatement, env *object.Environment, index string) *object.FunctionLiteral {
-	// var params []ast.VariableDecStatement
-	// for _, param := range fn.Parameters {
-	// 	tmp := ast.VariableDecStatement{
-	// 		Names:         []string{index},
-	// 		AssignedValue: nil,
-	// 		Type:          param,
-	// 		IsConstant:    false,
-	// 	}
-	// 	params = append(params, tmp)
-	// }
-	var returnTypes []object.ObjectType
-	for _, _type := range fn.ReturnTypes {
-		switch _type.(type) {
-		case *ast.ArrayType:
-			returnTypes = append(returnTypes, object.ARRAY)
-		}
-		if _type.(*ast.SymbolType).Name == "string" {
-			returnTypes = append(returnTypes, object.STRING)
-		} else if _type.(*ast.SymbolType).Name == "bool" {
-			returnTypes = append(returnTypes, object.BOOLEAN)
-		} else if _type.(*ast.SymbolType).Name == "int" {
-			returnTypes = append(returnTypes, object.INTEGER)
-		} else if _type.(*ast.SymbolType).Name == "array" {
-			returnTypes = append(returnTypes, object.ARRAY)
-		}
-	}
-	function, ok := env.Get(index)
-	if !ok {
-		newError("Не найдено определение функции: %s", index)
+// EvaluateClassField возвращает начальное значение поля: объявленное
+// значение по умолчанию или нулевое значение его типа.
+func EvaluateClassField(variable ast.ClassFieldStatement, env *object.Environment, bindings typeBindings) object.Object {
+	if variable.Default == nil {
+		return zeroValue(substituteType(variable.Type, bindings))
+	}
+	value := Evaluate(variable.Default, env)
+	if IsError(value) {
+		return value
 	}
-	body := function.(*object.FunctionLiteral).Body
-	env.Delete(index)
+	if !matchType(value, variable.Type, bindings) {
+		return newError("Значение по умолчанию %s не соответствует типу поля %s", value.Inspect(), typeName(substituteType(variable.Type, bindings)))
+	}
+	return value
+}
+
+// newFieldValues создаёт свежие значения всех полей для нового объекта
+// с параметрами типа typeArguments.
+func newFieldValues(class *object.Class, typeArguments map[string]ast.Type) (map[string]object.Object, object.Object) {
+	fields := make(map[string]object.Object, len(class.Declarations))
+	for name, declaration := range class.Declarations {
+		if declaration.IsStatic {
+			continue
+		}
+		value := EvaluateClassField(declaration, class.Env, typeArguments)
+		if IsError(value) {
+			return nil, value
+		}
+		fields[name] = value
+	}
+	return fields, nil
+}
+
+func EvaluateFunctionField(className string, fn ast.ClassFunctionStatement, env *object.Environment, index string) *object.FunctionLiteral {
 	return &object.FunctionLiteral{
-		Env:        env,
-		Parameters: function.(*object.FunctionLiteral).Parameters,
-		Body:       body,
-		ReturnType: returnTypes,
-		IsMethod:   true,
-		ClassName:  className,
+		Name:           className + "." + index,
+		TypeParameters: fn.TypeParameters,
+		Env:            env,
+		Parameters:     fn.Parameters,
+		Body:           fn.Body,
+		ReturnType:     fn.ReturnTypes,
+		IsMethod:       true,
+		ClassName:      className,
 	}
 }
 
 func EvaluateIf(node ast.IfStatement, env *object.Environment) object.Object {
 	condition := Evaluate(node.Condition, env)
+	if IsError(condition) {
+		return condition
+	}
 	if isTruthy(condition) {
 		return Execute(node.ThenBlock, env)
 	} else if node.ElseBlock != nil {
@@ -702,15 +1291,33 @@ func ExecuteBlock(block ast.BlockStatement, env *object.Environment) object.Obje
 	for _, statement := range block.Statements {
 		result = Execute(statement, env)
 
-		if result != nil && result.Type() == object.RETURN_VALUE {
+		if result != nil && (result.Type() == object.RETURN_VALUE || result.Type() == object.ERROR) {
 			return result
 		}
 	}
 	return result
 }
 
+// Parse разбирает исходный код, превращая панику лексера или парсера
+// в ошибку.
+func Parse(src string) (program ast.BlockStatement, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	tokens := lexer.Tokenize(src)
+	return parser.Parse(tokens)
+}
+
 func ExecuteImportStat(stat ast.ImportStatement, env *object.Environment) object.Object {
 	modulePath := stat.PackagePath
+	if loader, ok := nativeModules[modulePath]; ok {
+		return importNativeModule(stat.ImportName, loader, env)
+	}
+	if err := env.Runtime().CheckRead(modulePath); err != nil {
+		return err
+	}
 	file, err := os.Open(modulePath)
 	if err != nil {
 		return newError("Ошибка при нахождении пакета: %s", modulePath)
@@ -720,14 +1327,17 @@ func ExecuteImportStat(stat ast.ImportStatement, env *object.Environment) object
 	if err != nil {
 		return newError("Ошибка при чтении файла: %s", modulePath)
 	}
-	tokens := lexer.Tokenize(string(input))
-	ast, err := parser.Parse(tokens)
+	ast, err := Parse(string(input))
 	if err != nil {
-		return newError("Ошибка при парсинге файла: %s", err)
+		return newError("Ошибка при парсинге файла %s: %s", modulePath, err)
+	}
+	if err := checker.Check(ast); err != nil {
+		return newError("Ошибка при проверке файла %s: %s", modulePath, err)
 	}
 	enviroment := object.NewEnvironment()
-	for _, stmt := range ast.Statements {
-		Execute(stmt, enviroment)
+	enviroment.SetRuntime(env.Runtime())
+	if result := ExecuteProgram(ast, enviroment); IsError(result) {
+		return result
 	}
 	module := &object.Module{
 		Name:        stat.ImportName,
@@ -0,0 +1,74 @@
+package runner
+
+import (
+	"meow/source/ast"
+	"meow/source/runner/object"
+)
+
+// builtinClasses доступны в любой программе без объявления.
+var builtinClasses = map[string]*object.Class{
+	"Error": {
+		Name: "Error",
+		Fields: map[string]object.Object{
+			"message": &object.String{},
+			"stack":   &object.Array{ElementsType: object.STRING},
+		},
+		Declarations: map[string]ast.ClassFieldStatement{
+			"message": {Type: &ast.SymbolType{Name: "string"}},
+			"stack":   {Type: &ast.ArrayType{Underlying: &ast.SymbolType{Name: "string"}}},
+		},
+		Functions:       map[string]object.Object{},
+		StaticFields:    map[string]object.Object{},
+		StaticFunctions: map[string]object.Object{},
+		Private:         map[string]bool{},
+	},
+}
+
+func ExecuteThrow(node ast.ThrowStatement, env *object.Environment) object.Object {
+	value := Evaluate(node.Value, env)
+	if IsError(value) {
+		return value
+	}
+	message := value.Inspect()
+	if class, ok := value.(*object.Class); ok && isInstanceOf(class, "Error") {
+		if msg, ok := class.Fields["message"]; ok {
+			message = msg.Inspect()
+		}
+	}
+	return &object.Error{Message: message, Value: value}
+}
+
+func ExecuteTry(node ast.TryStatement, env *object.Environment) object.Object {
+	result := ExecuteBlock(*node.Body, env)
+	if err, ok := result.(*object.Error); ok && !err.Fatal && node.CatchBlock != nil {
+		env.Set(node.CatchName, caughtValue(err))
+		result = ExecuteBlock(*node.CatchBlock, env)
+	}
+	if node.FinallyBlock != nil {
+		finally := ExecuteBlock(*node.FinallyBlock, env)
+		if finally != nil && (finally.Type() == object.ERROR || finally.Type() == object.RETURN_VALUE) {
+			return finally
+		}
+	}
+	return result
+}
+
+// caughtValue возвращает значение, которое получит блок catch: то, что было
+// передано в throw, либо экземпляр Error для ошибок интерпретатора.
+func caughtValue(err *object.Error) object.Object {
+	stackElements := make([]object.Object, 0, len(err.Stack))
+	for _, frame := range err.Stack {
+		stackElements = append(stackElements, &object.String{Value: []rune(frame)})
+	}
+	stack := &object.Array{Elements: stackElements, ElementsType: object.STRING}
+	if err.Value == nil {
+		return newInstance(builtinClasses["Error"], map[string]object.Object{
+			"message": &object.String{Value: []rune(err.Message)},
+			"stack":   stack,
+		})
+	}
+	if class, ok := err.Value.(*object.Class); ok && isInstanceOf(class, "Error") {
+		class.Fields["stack"] = stack
+	}
+	return err.Value
+}
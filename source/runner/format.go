@@ -0,0 +1,100 @@
+package runner
+
+import (
+	"fmt"
+	"meow/source/runner/object"
+	"strings"
+)
+
+// formatString подставляет аргументы в строку формата. Поддерживаются
+// флаги - + 0 # и пробел, ширина, точность и глаголы %d %f %s %v %x %X %q.
+func formatString(format string, args []object.Object) object.Object {
+	var sb strings.Builder
+	next := 0
+	runes := []rune(format)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '%' {
+			sb.WriteRune(runes[i])
+			continue
+		}
+		start := i
+		i++
+		for i < len(runes) && strings.ContainsRune("-+0# ", runes[i]) {
+			i++
+		}
+		for i < len(runes) && runes[i] >= '0' && runes[i] <= '9' {
+			i++
+		}
+		if i < len(runes) && runes[i] == '.' {
+			i++
+			for i < len(runes) && runes[i] >= '0' && runes[i] <= '9' {
+				i++
+			}
+		}
+		if i >= len(runes) {
+			return newError("Незавершённый спецификатор в строке формата: %s", string(runes[start:]))
+		}
+		verb := runes[i]
+		if verb == '%' {
+			sb.WriteRune('%')
+			continue
+		}
+		if next >= len(args) {
+			return newError("Недостаточно аргументов для строки формата: %s", format)
+		}
+		text, err := formatValue(string(runes[start:i]), verb, args[next])
+		if err != nil {
+			return err
+		}
+		sb.WriteString(text)
+		next++
+	}
+	if next < len(args) {
+		return newError("Лишние аргументы для строки формата: %d", len(args)-next)
+	}
+	return &object.String{Value: []rune(sb.String())}
+}
+
+// formatValue форматирует одно значение; spec — спецификатор без глагола.
+func formatValue(spec string, verb rune, arg object.Object) (string, object.Object) {
+	goSpec := spec + string(verb)
+	switch verb {
+	case 'd':
+		if integer, ok := arg.(*object.Integer); ok {
+			return fmt.Sprintf(goSpec, integer.Value), nil
+		}
+	case 'f':
+		switch number := arg.(type) {
+		case *object.Float:
+			return fmt.Sprintf(goSpec, number.Value), nil
+		case *object.Integer:
+			return fmt.Sprintf(goSpec, float64(number.Value)), nil
+		}
+	case 'x', 'X':
+		switch value := arg.(type) {
+		case *object.Integer:
+			return fmt.Sprintf(goSpec, value.Value), nil
+		case *object.String:
+			return fmt.Sprintf(goSpec, string(value.Value)), nil
+		}
+	case 's', 'v', 'q':
+		if str, ok := arg.(*object.String); ok {
+			return fmt.Sprintf(spec+"s", quoteIf(verb, string(str.Value))), nil
+		}
+		text, err := toDisplayString(arg)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(spec+"s", quoteIf(verb, text)), nil
+	default:
+		return "", newError("Неизвестный глагол формата: %%%c", verb)
+	}
+	return "", newError("Формат %%%c не подходит для значения типа %s", verb, arg.Type())
+}
+
+func quoteIf(verb rune, text string) string {
+	if verb == 'q' {
+		return fmt.Sprintf("%q", text)
+	}
+	return text
+}
@@ -0,0 +1,310 @@
+package runner
+
+import (
+	"meow/source/ast"
+	"meow/source/runner/object"
+)
+
+// typeBindings связывает параметры типа с подставленными типами.
+// Значение nil означает, что параметр ещё не выведен из аргументов.
+type typeBindings map[string]ast.Type
+
+// newTypeBindings создаёт связывания для вызова: параметры типа функции
+// выводятся заново, параметры класса берутся из экземпляра.
+func newTypeBindings(parameters []string, instance object.Object) typeBindings {
+	bindings := typeBindings{}
+	if class, ok := instance.(*object.Class); ok {
+		for name, _type := range class.TypeArguments {
+			bindings[name] = _type
+		}
+	}
+	for _, name := range parameters {
+		bindings[name] = nil
+	}
+	return bindings
+}
+
+// saveTo запоминает в экземпляре параметры класса, выведенные при вызове.
+func (b typeBindings) saveTo(instance object.Object) {
+	class, ok := instance.(*object.Class)
+	if !ok {
+		return
+	}
+	for name, _type := range class.TypeArguments {
+		if _type == nil && b[name] != nil {
+			class.TypeArguments[name] = b[name]
+		}
+	}
+}
+
+// inferTypeBindings заново выводит параметры типа по аргументам вызова,
+// чтобы проверить возвращаемые значения.
+func inferTypeBindings(fn *object.FunctionLiteral, args []object.Object) typeBindings {
+	var instance object.Object
+	if fn.IsMethod && len(args) > 0 {
+		instance, args = args[0], args[1:]
+	}
+	bindings := newTypeBindings(fn.TypeParameters, instance)
+	if len(fn.TypeParameters) == 0 {
+		return bindings
+	}
+	for i, param := range fn.Parameters {
+		if i >= len(args) {
+			break
+		}
+		_type := param.Type
+		if param.IsVariadic {
+			_type = &ast.ArrayType{Underlying: _type}
+		}
+		matchType(args[i], _type, bindings)
+	}
+	return bindings
+}
+
+// newTypeArguments создаёт параметры типа нового экземпляра из явно
+// указанных типов ^^Box<int>(...); неуказанные выводятся позже.
+func newTypeArguments(class *object.Class, arguments []ast.Type) (map[string]ast.Type, object.Object) {
+	if len(arguments) > 0 && len(arguments) != len(class.TypeParameters) {
+		return nil, newError("Класс %s ожидает параметров типа: %d, но получено %d",
+			class.Name, len(class.TypeParameters), len(arguments))
+	}
+	if len(class.TypeParameters) == 0 {
+		return nil, nil
+	}
+	typeArguments := make(map[string]ast.Type, len(class.TypeParameters))
+	for i, name := range class.TypeParameters {
+		typeArguments[name] = nil
+		if len(arguments) > 0 {
+			typeArguments[name] = arguments[i]
+		}
+	}
+	return typeArguments, nil
+}
+
+// matchType проверяет, подходит ли значение под тип, заходя внутрь
+// массивов и словарей. Параметры типа без значения в bindings
+// связываются с типом первого подошедшего значения.
+func matchType(obj object.Object, _type ast.Type, bindings typeBindings) bool {
+	switch t := _type.(type) {
+	case *ast.NullableType:
+		return obj.Type() == object.NULL || matchType(obj, t.Underlying, bindings)
+	case *ast.SymbolType:
+		if bound, ok := bindings[t.Name]; ok {
+			if bound == nil {
+				bindings[t.Name] = typeOf(obj)
+				return true
+			}
+			return matchType(obj, bound, nil)
+		}
+		switch obj := obj.(type) {
+		case *object.Class:
+			return isInstanceOf(obj, t.Name) && matchTypeArguments(obj, t, bindings)
+		case *object.EnumValue:
+			return obj.Enum.Name == t.Name
+		}
+		return checkTypes(obj, t.Name)
+	case *ast.ArrayType:
+		arr, ok := obj.(*object.Array)
+		if !ok {
+			return false
+		}
+		if isPrimitive(t.Underlying, bindings) && arr.ElementsType != "" {
+			return arr.ElementsType == objectTypeOf(t.Underlying)
+		}
+		if len(arr.Elements) == 0 {
+			return unifyTypes(primitiveType(arr.ElementsType), t.Underlying, bindings)
+		}
+		for _, elem := range arr.Elements {
+			if !matchType(elem, t.Underlying, bindings) {
+				return false
+			}
+		}
+		return true
+	case *ast.MapType:
+		m, ok := obj.(*object.Map)
+		if !ok {
+			return false
+		}
+		if len(m.Keys) == 0 {
+			return unifyTypes(primitiveType(m.KeyType), t.Key, bindings) &&
+				unifyTypes(primitiveType(m.ValueType), t.Value, bindings)
+		}
+		for _, key := range m.Keys {
+			value, _ := m.Get(key)
+			if !matchType(key, t.Key, bindings) || !matchType(value, t.Value, bindings) {
+				return false
+			}
+		}
+		return true
+	}
+	return true
+}
+
+// matchTypeArguments сверяет параметры типа экземпляра с указанными в
+// типе Box<int>. Невыведенные параметры экземпляра при этом выводятся.
+func matchTypeArguments(instance *object.Class, _type *ast.SymbolType, bindings typeBindings) bool {
+	if len(_type.Arguments) == 0 || instance.Class == nil || instance.Name != _type.Name {
+		return true
+	}
+	for i, name := range instance.Class.TypeParameters {
+		if i >= len(_type.Arguments) {
+			break
+		}
+		actual := instance.TypeArguments[name]
+		if actual == nil {
+			expected := substituteType(_type.Arguments[i], bindings)
+			if !hasTypeParameters(expected, bindings) {
+				instance.TypeArguments[name] = expected
+			}
+			continue
+		}
+		if !unifyTypes(actual, _type.Arguments[i], bindings) {
+			return false
+		}
+	}
+	return true
+}
+
+// unifyTypes сверяет два объявленных типа, связывая параметры типа
+// из expected. nil означает неизвестный тип и подходит под любой.
+func unifyTypes(actual, expected ast.Type, bindings typeBindings) bool {
+	if actual == nil || expected == nil {
+		return true
+	}
+	if symbol, ok := expected.(*ast.SymbolType); ok {
+		if bound, ok := bindings[symbol.Name]; ok {
+			if bound == nil {
+				bindings[symbol.Name] = actual
+				return true
+			}
+			return unifyTypes(actual, bound, nil)
+		}
+	}
+	switch e := expected.(type) {
+	case *ast.NullableType:
+		if a, ok := actual.(*ast.NullableType); ok {
+			return unifyTypes(a.Underlying, e.Underlying, bindings)
+		}
+		return unifyTypes(actual, e.Underlying, bindings)
+	case *ast.SymbolType:
+		a, ok := actual.(*ast.SymbolType)
+		if !ok || a.Name != e.Name {
+			return false
+		}
+		for i := 0; i < len(a.Arguments) && i < len(e.Arguments); i++ {
+			if !unifyTypes(a.Arguments[i], e.Arguments[i], bindings) {
+				return false
+			}
+		}
+		return true
+	case *ast.ArrayType:
+		a, ok := actual.(*ast.ArrayType)
+		return ok && unifyTypes(a.Underlying, e.Underlying, bindings)
+	case *ast.MapType:
+		a, ok := actual.(*ast.MapType)
+		return ok && unifyTypes(a.Key, e.Key, bindings) && unifyTypes(a.Value, e.Value, bindings)
+	}
+	return false
+}
+
+// typeOf возвращает объявленный тип значения; nil, если тип неизвестен.
+func typeOf(obj object.Object) ast.Type {
+	switch obj := obj.(type) {
+	case *object.Array:
+		if len(obj.Elements) > 0 {
+			return &ast.ArrayType{Underlying: typeOf(obj.Elements[0])}
+		}
+		return &ast.ArrayType{Underlying: primitiveType(obj.ElementsType)}
+	case *object.Map:
+		if len(obj.Keys) > 0 {
+			value, _ := obj.Get(obj.Keys[0])
+			return &ast.MapType{Key: typeOf(obj.Keys[0]), Value: typeOf(value)}
+		}
+		return &ast.MapType{Key: primitiveType(obj.KeyType), Value: primitiveType(obj.ValueType)}
+	case *object.Class:
+		if obj.Class == nil {
+			return nil
+		}
+		_type := &ast.SymbolType{Name: obj.Name}
+		for _, name := range obj.Class.TypeParameters {
+			_type.Arguments = append(_type.Arguments, obj.TypeArguments[name])
+		}
+		return _type
+	case *object.EnumValue:
+		return &ast.SymbolType{Name: obj.Enum.Name}
+	}
+	return primitiveType(obj.Type())
+}
+
+func primitiveType(objectType object.ObjectType) ast.Type {
+	for name, t := range typesInStrings {
+		if t == objectType && name != "array" && name != "map" {
+			return &ast.SymbolType{Name: name}
+		}
+	}
+	return nil
+}
+
+// isPrimitive сообщает, что тип — число, строка или bool, а не параметр типа.
+func isPrimitive(_type ast.Type, bindings typeBindings) bool {
+	symbol, ok := _type.(*ast.SymbolType)
+	if !ok {
+		return false
+	}
+	if _, isParameter := bindings[symbol.Name]; isParameter {
+		return false
+	}
+	switch symbol.Name {
+	case "int", "float", "string", "bool":
+		return true
+	}
+	return false
+}
+
+// substituteType подставляет в тип уже выведенные параметры типа.
+func substituteType(_type ast.Type, bindings typeBindings) ast.Type {
+	switch t := _type.(type) {
+	case *ast.SymbolType:
+		if bound, ok := bindings[t.Name]; ok && bound != nil {
+			return bound
+		}
+		if len(t.Arguments) == 0 {
+			return t
+		}
+		arguments := make([]ast.Type, 0, len(t.Arguments))
+		for _, argument := range t.Arguments {
+			arguments = append(arguments, substituteType(argument, bindings))
+		}
+		return &ast.SymbolType{Name: t.Name, Arguments: arguments}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Underlying: substituteType(t.Underlying, bindings)}
+	case *ast.MapType:
+		return &ast.MapType{Key: substituteType(t.Key, bindings), Value: substituteType(t.Value, bindings)}
+	case *ast.NullableType:
+		return &ast.NullableType{Underlying: substituteType(t.Underlying, bindings)}
+	}
+	return _type
+}
+
+func hasTypeParameters(_type ast.Type, bindings typeBindings) bool {
+	switch t := _type.(type) {
+	case *ast.SymbolType:
+		if _, ok := bindings[t.Name]; ok {
+			return true
+		}
+		for _, argument := range t.Arguments {
+			if hasTypeParameters(argument, bindings) {
+				return true
+			}
+		}
+	case *ast.ArrayType:
+		return hasTypeParameters(t.Underlying, bindings)
+	case *ast.MapType:
+		return hasTypeParameters(t.Key, bindings) || hasTypeParameters(t.Value, bindings)
+	case *ast.NullableType:
+		return hasTypeParameters(t.Underlying, bindings)
+	case nil:
+		return true
+	}
+	return false
+}
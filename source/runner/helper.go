@@ -3,8 +3,9 @@ package runner
 import (
 	"fmt"
 	"math"
+	"meow/source/ast"
 	"meow/source/runner/object"
-	"os"
+	"strings"
 )
 
 func nativeBoolToBooleanObject(value bool) *object.Boolean {
@@ -36,8 +37,6 @@ func isAllTruthy(obj []object.Object) bool {
 }
 
 func newError(format string, a ...interface{}) *object.Error {
-	fmt.Println(fmt.Sprintf(format, a...))
-	os.Exit(1)
 	return &object.Error{Message: fmt.Sprintf(format, a...)}
 }
 
@@ -48,34 +47,162 @@ func IsError(obj object.Object) bool {
 	return false
 }
 
-func checkForDefault(name string) bool {
-	switch name {
-	case "meow":
-		return true
-	case "len":
-		return true
-	case "tail":
-		return true
-	case "string":
-		return true
-	case "typeof":
-		return true
-	}
-	return false
-}
-
 var typesInStrings = map[string]object.ObjectType{
 	"int":    object.INTEGER,
 	"float":  object.FLOAT,
 	"string": object.STRING,
 	"bool":   object.BOOLEAN,
 	"array":  object.ARRAY,
+	"map":    object.MAP,
 }
 
 func checkTypes(obj object.Object, _type string) bool {
 	return obj.Type() == typesInStrings[_type]
 }
 
+// checkParamType проверяет, подходит ли значение под объявленный тип параметра.
+func checkParamType(obj object.Object, _type ast.Type) bool {
+	return matchType(obj, _type, nil)
+}
+
+// isInstanceOf проверяет, является ли объект экземпляром класса name,
+// одного из его наследников или реализует ли интерфейс name.
+func isInstanceOf(class *object.Class, name string) bool {
+	for c := class; c != nil; c = c.Parent {
+		if c.Name == name {
+			return true
+		}
+		for _, iface := range c.Interfaces {
+			if iface.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// privateOwner возвращает класс, объявивший приватный член name, или nil,
+// если член не приватный. Наследник получает приватные члены родителя
+// копией, поэтому владелец — самый дальний предок, где член приватный.
+func privateOwner(class *object.Class, name string) *object.Class {
+	var owner *object.Class
+	for c := class; c != nil; c = c.Parent {
+		if c.Private[name] {
+			owner = c
+		}
+	}
+	return owner
+}
+
+// declaredNames возвращает имена, которые объявляет инструкция.
+func declaredNames(stmt ast.Statement) []string {
+	switch stmt := stmt.(type) {
+	case *ast.FunctionDecStatement:
+		return []string{stmt.Name}
+	case *ast.VariableDecStatement:
+		return stmt.Names
+	case *ast.ClassDecStatement:
+		return []string{stmt.Name}
+	case *ast.InterfaceDecStatement:
+		return []string{stmt.Name}
+	case *ast.EnumDecStatement:
+		return []string{stmt.Name}
+	}
+	return nil
+}
+
+func parameterTypes(params []ast.VariableDecStatement) []ast.Type {
+	types := make([]ast.Type, 0, len(params))
+	for _, param := range params {
+		types = append(types, param.Type)
+	}
+	return types
+}
+
+func sameTypes(a, b []ast.Type) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if typeName(a[i]) != typeName(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// objectTypeOf возвращает тип объекта, которым представлен объявленный тип.
+func objectTypeOf(_type ast.Type) object.ObjectType {
+	switch t := _type.(type) {
+	case *ast.ArrayType:
+		return object.ARRAY
+	case *ast.MapType:
+		return object.MAP
+	case *ast.NullableType:
+		return objectTypeOf(t.Underlying)
+	case *ast.SymbolType:
+		if objectType, ok := typesInStrings[t.Name]; ok {
+			return objectType
+		}
+	}
+	return object.CLASS
+}
+
+// zeroValue возвращает значение по умолчанию для объявленного типа.
+func zeroValue(_type ast.Type) object.Object {
+	switch t := _type.(type) {
+	case *ast.ArrayType:
+		return &object.Array{Elements: []object.Object{}, ElementsType: objectTypeOf(t.Underlying)}
+	case *ast.MapType:
+		return newMap(objectTypeOf(t.Key), objectTypeOf(t.Value))
+	case *ast.SymbolType:
+		switch t.Name {
+		case "string":
+			return &object.String{Value: []rune{}}
+		case "int":
+			return &object.Integer{}
+		case "bool":
+			return FALSE
+		case "float":
+			return &object.Float{}
+		case "array":
+			return &object.Array{Elements: []object.Object{}}
+		case "map":
+			return newMap("", "")
+		}
+	}
+	return NULL
+}
+
+func newMap(keyType, valueType object.ObjectType) *object.Map {
+	return &object.Map{
+		Values:    map[object.MapKey]object.Object{},
+		KeyType:   keyType,
+		ValueType: valueType,
+	}
+}
+
+func typeName(_type ast.Type) string {
+	switch t := _type.(type) {
+	case *ast.SymbolType:
+		if len(t.Arguments) == 0 {
+			return t.Name
+		}
+		arguments := make([]string, 0, len(t.Arguments))
+		for _, argument := range t.Arguments {
+			arguments = append(arguments, typeName(argument))
+		}
+		return t.Name + "<" + strings.Join(arguments, ", ") + ">"
+	case *ast.ArrayType:
+		return "[]" + typeName(t.Underlying)
+	case *ast.MapType:
+		return "map[" + typeName(t.Key) + "]" + typeName(t.Value)
+	case *ast.NullableType:
+		return typeName(t.Underlying) + "?"
+	}
+	return ""
+}
+
 func isWhole(x float64) bool {
 	return x == math.Floor(x) || x == math.Ceil(x)
 }
@@ -0,0 +1,61 @@
+package runner
+
+import (
+	"meow/source/ast"
+	"meow/source/runner/object"
+)
+
+func evaluateMapDeclaration(node *ast.MapDeclaration, env *object.Environment) object.Object {
+	if err := env.Runtime().Allocate(); err != nil {
+		return err
+	}
+	m := newMap("", "")
+	for i := range node.Keys {
+		key := Evaluate(node.Keys[i], env)
+		if IsError(key) {
+			return key
+		}
+		value := Evaluate(node.Values[i], env)
+		if IsError(value) {
+			return value
+		}
+		if err := setMapValue(m, key, value); err != nil {
+			return err
+		}
+	}
+	return m
+}
+
+// setMapValue записывает пару в словарь. Все ключи словаря, как и все
+// значения, должны быть одного типа.
+func setMapValue(m *object.Map, key, value object.Object) object.Object {
+	if _, ok := object.KeyOf(key); !ok {
+		return newError("Значение типа %s не может быть ключом словаря", key.Type())
+	}
+	if m.KeyType == "" {
+		m.KeyType = key.Type()
+	}
+	if m.ValueType == "" {
+		m.ValueType = value.Type()
+	}
+	if key.Type() != m.KeyType {
+		return newError("Ключ словаря должен быть %s, получено %s", m.KeyType, key.Type())
+	}
+	if value.Type() != m.ValueType {
+		return newError("Значение словаря должно быть %s, получено %s", m.ValueType, value.Type())
+	}
+	m.Set(key, value)
+	return nil
+}
+
+func evalMapIndexExpression(mapObject, key object.Object) object.Object {
+	m := mapObject.(*object.Map)
+	if _, ok := object.KeyOf(key); !ok {
+		return newError("Значение типа %s не может быть ключом словаря", key.Type())
+	}
+	value, ok := m.Get(key)
+	if !ok {
+		return newError("Ключ %s не найден в словаре", key.Inspect())
+	}
+	return value
+}
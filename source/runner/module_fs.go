@@ -0,0 +1,203 @@
+package runner
+
+import (
+	"errors"
+	"io/fs"
+	"meow/source/ast"
+	"meow/source/runner/object"
+	"os"
+	"path/filepath"
+	"sort"
+	"syscall"
+)
+
+// fileInfoClass — класс результата fs.stat; он доступен как fs.FileInfo.
+var fileInfoClass = &object.Class{
+	Name: "FileInfo",
+	Fields: map[string]object.Object{
+		"name":     &object.String{},
+		"size":     &object.Integer{},
+		"isDir":    FALSE,
+		"modified": &object.Integer{},
+	},
+	Declarations: map[string]ast.ClassFieldStatement{
+		"name":     {Type: &ast.SymbolType{Name: "string"}},
+		"size":     {Type: &ast.SymbolType{Name: "int"}},
+		"isDir":    {Type: &ast.SymbolType{Name: "bool"}},
+		"modified": {Type: &ast.SymbolType{Name: "int"}},
+	},
+	Functions:       map[string]object.Object{},
+	StaticFields:    map[string]object.Object{},
+	StaticFunctions: map[string]object.Object{},
+	Private:         map[string]bool{},
+}
+
+func init() {
+	str := object.STRING
+	fsMembers := moduleFunctions(
+		&object.Builtin{Name: "readFile", Arity: 1, ParamTypes: []object.ObjectType{str}, Fn: fsReadFile},
+		&object.Builtin{Name: "writeFile", Arity: 2, ParamTypes: []object.ObjectType{str, str}, Fn: fsWriteFile},
+		&object.Builtin{Name: "append", Arity: 2, ParamTypes: []object.ObjectType{str, str}, Fn: fsAppend},
+		&object.Builtin{Name: "listDir", Arity: 1, ParamTypes: []object.ObjectType{str}, Fn: fsListDir},
+		&object.Builtin{Name: "exists", Arity: 1, ParamTypes: []object.ObjectType{str}, Fn: fsExists},
+		&object.Builtin{Name: "mkdir", Arity: 1, ParamTypes: []object.ObjectType{str}, Fn: fsMkdir},
+		&object.Builtin{Name: "remove", Arity: 1, ParamTypes: []object.ObjectType{str}, Fn: fsRemove},
+		&object.Builtin{Name: "stat", Arity: 1, ParamTypes: []object.ObjectType{str}, Fn: fsStat},
+	)
+	fsMembers["FileInfo"] = fileInfoClass
+	RegisterModule("fs", fsMembers)
+	RegisterModule("path", moduleFunctions(
+		&object.Builtin{Name: "join", Arity: -1, Fn: pathJoin},
+		&object.Builtin{Name: "base", Arity: 1, ParamTypes: []object.ObjectType{str}, Fn: pathFunction(filepath.Base)},
+		&object.Builtin{Name: "dir", Arity: 1, ParamTypes: []object.ObjectType{str}, Fn: pathFunction(filepath.Dir)},
+		&object.Builtin{Name: "ext", Arity: 1, ParamTypes: []object.ObjectType{str}, Fn: pathFunction(filepath.Ext)},
+		&object.Builtin{Name: "abs", Arity: 1, ParamTypes: []object.ObjectType{str}, Fn: pathAbs},
+	))
+}
+
+// fsError переводит ошибку файловой системы в ошибку, которую ловит catch.
+func fsError(action, path string, err error) *object.Error {
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return newError("%s %s: не существует", action, path)
+	case errors.Is(err, syscall.ENOTEMPTY):
+		return newError("%s %s: каталог не пуст", action, path)
+	case errors.Is(err, fs.ErrExist):
+		return newError("%s %s: уже существует", action, path)
+	case errors.Is(err, fs.ErrPermission):
+		return newError("%s %s: нет прав доступа", action, path)
+	}
+	return newError("%s %s: %s", action, path, err)
+}
+
+func fsReadFile(env *object.Environment, args ...object.Object) object.Object {
+	path := goString(args[0])
+	if err := env.Runtime().CheckRead(path); err != nil {
+		return err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fsError("Не удалось прочитать файл", path, err)
+	}
+	return newString(string(data))
+}
+
+func fsWriteFile(env *object.Environment, args ...object.Object) object.Object {
+	return writeFile(env, goString(args[0]), goString(args[1]), os.O_TRUNC)
+}
+
+func fsAppend(env *object.Environment, args ...object.Object) object.Object {
+	return writeFile(env, goString(args[0]), goString(args[1]), os.O_APPEND)
+}
+
+func writeFile(env *object.Environment, path, content string, mode int) object.Object {
+	if err := env.Runtime().CheckWrite(path); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|mode, 0o644)
+	if err != nil {
+		return fsError("Не удалось записать файл", path, err)
+	}
+	defer file.Close()
+	if _, err := file.WriteString(content); err != nil {
+		return fsError("Не удалось записать файл", path, err)
+	}
+	return NULL
+}
+
+func fsListDir(env *object.Environment, args ...object.Object) object.Object {
+	path := goString(args[0])
+	if err := env.Runtime().CheckRead(path); err != nil {
+		return err
+	}
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return fsError("Не удалось прочитать каталог", path, err)
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+	return newStringArray(names)
+}
+
+func fsExists(env *object.Environment, args ...object.Object) object.Object {
+	path := goString(args[0])
+	if err := env.Runtime().CheckRead(path); err != nil {
+		return err
+	}
+	_, err := os.Stat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return FALSE
+	}
+	if err != nil {
+		return fsError("Не удалось проверить путь", path, err)
+	}
+	return TRUE
+}
+
+func fsMkdir(env *object.Environment, args ...object.Object) object.Object {
+	path := goString(args[0])
+	if err := env.Runtime().CheckWrite(path); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		return fsError("Не удалось создать каталог", path, err)
+	}
+	return NULL
+}
+
+// fsRemove удаляет файл или пустой каталог.
+func fsRemove(env *object.Environment, args ...object.Object) object.Object {
+	path := goString(args[0])
+	if err := env.Runtime().CheckWrite(path); err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil {
+		return fsError("Не удалось удалить", path, err)
+	}
+	return NULL
+}
+
+func fsStat(env *object.Environment, args ...object.Object) object.Object {
+	path := goString(args[0])
+	if err := env.Runtime().CheckRead(path); err != nil {
+		return err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return fsError("Не удалось получить сведения о файле", path, err)
+	}
+	return newInstance(fileInfoClass, map[string]object.Object{
+		"name":     newString(info.Name()),
+		"size":     &object.Integer{Value: info.Size()},
+		"isDir":    nativeBoolToBooleanObject(info.IsDir()),
+		"modified": &object.Integer{Value: info.ModTime().Unix()},
+	})
+}
+
+func pathJoin(env *object.Environment, args ...object.Object) object.Object {
+	parts := make([]string, 0, len(args))
+	for _, arg := range args {
+		if arg.Type() != object.STRING {
+			return newError("Неверный аргумент %s функции join: ожидается STRING", arg.Type())
+		}
+		parts = append(parts, goString(arg))
+	}
+	return newString(filepath.Join(parts...))
+}
+
+func pathFunction(fn func(string) string) object.BuiltinFunction {
+	return func(env *object.Environment, args ...object.Object) object.Object {
+		return newString(fn(goString(args[0])))
+	}
+}
+
+func pathAbs(env *object.Environment, args ...object.Object) object.Object {
+	path, err := filepath.Abs(goString(args[0]))
+	if err != nil {
+		return newError("Невозможно получить абсолютный путь %s: %s", goString(args[0]), err)
+	}
+	return newString(path)
+}
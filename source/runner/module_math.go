@@ -0,0 +1,271 @@
+package runner
+
+import (
+	"cmp"
+	"math"
+	"math/rand"
+	"meow/source/runner/object"
+)
+
+func init() {
+	integer := object.INTEGER
+	members := moduleFunctions(
+		&object.Builtin{Name: "abs", Arity: 1, Fn: mathAbs},
+		&object.Builtin{Name: "sqrt", Arity: 1, Fn: floatFunction("sqrt", math.Sqrt)},
+		&object.Builtin{Name: "pow", Arity: 2, Fn: mathPow},
+		&object.Builtin{Name: "floor", Arity: 1, Fn: roundFunction("floor", math.Floor)},
+		&object.Builtin{Name: "ceil", Arity: 1, Fn: roundFunction("ceil", math.Ceil)},
+		&object.Builtin{Name: "round", Arity: 1, Fn: roundFunction("round", math.Round)},
+		&object.Builtin{Name: "sin", Arity: 1, Fn: floatFunction("sin", math.Sin)},
+		&object.Builtin{Name: "cos", Arity: 1, Fn: floatFunction("cos", math.Cos)},
+		&object.Builtin{Name: "tan", Arity: 1, Fn: floatFunction("tan", math.Tan)},
+		&object.Builtin{Name: "asin", Arity: 1, Fn: floatFunction("asin", math.Asin)},
+		&object.Builtin{Name: "acos", Arity: 1, Fn: floatFunction("acos", math.Acos)},
+		&object.Builtin{Name: "atan", Arity: 1, Fn: floatFunction("atan", math.Atan)},
+		&object.Builtin{Name: "atan2", Arity: 2, Fn: mathAtan2},
+		&object.Builtin{Name: "log", Arity: 1, Fn: floatFunction("log", math.Log)},
+		&object.Builtin{Name: "log2", Arity: 1, Fn: floatFunction("log2", math.Log2)},
+		&object.Builtin{Name: "log10", Arity: 1, Fn: floatFunction("log10", math.Log10)},
+		&object.Builtin{Name: "exp", Arity: 1, Fn: floatFunction("exp", math.Exp)},
+		&object.Builtin{Name: "min", Arity: -1, Fn: extremumFunction("min", -1)},
+		&object.Builtin{Name: "max", Arity: -1, Fn: extremumFunction("max", 1)},
+		&object.Builtin{Name: "gcd", Arity: 2, ParamTypes: []object.ObjectType{integer, integer}, Fn: mathGcd},
+		&object.Builtin{Name: "clamp", Arity: 3, Fn: mathClamp},
+		&object.Builtin{Name: "random", Arity: 0, Fn: mathRandom},
+		&object.Builtin{Name: "randomInt", Arity: 2, ParamTypes: []object.ObjectType{integer, integer}, Fn: mathRandomInt},
+	)
+	members["pi"] = &object.Float{Value: math.Pi}
+	members["e"] = &object.Float{Value: math.E}
+	members["inf"] = &object.Float{Value: math.Inf(1)}
+	members["nan"] = &object.Float{Value: math.NaN()}
+	RegisterModule("math", members)
+}
+
+// toFloat возвращает число как float64; ok равно false для не чисел.
+func toFloat(obj object.Object) (value float64, ok bool) {
+	switch number := obj.(type) {
+	case *object.Integer:
+		return float64(number.Value), true
+	case *object.Float:
+		return number.Value, true
+	}
+	return 0, false
+}
+
+func floatArgs(fnName string, args []object.Object) ([]float64, object.Object) {
+	values := make([]float64, 0, len(args))
+	for _, arg := range args {
+		value, ok := toFloat(arg)
+		if !ok {
+			return nil, newError("Неверный аргумент %s функции %s: ожидается число", arg.Type(), fnName)
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+func allIntegers(args []object.Object) bool {
+	for _, arg := range args {
+		if arg.Type() != object.INTEGER {
+			return false
+		}
+	}
+	return true
+}
+
+// floatFunction оборачивает функцию float64 -> float64 из пакета math.
+func floatFunction(name string, fn func(float64) float64) object.BuiltinFunction {
+	return func(env *object.Environment, args ...object.Object) object.Object {
+		values, err := floatArgs(name, args)
+		if err != nil {
+			return err
+		}
+		return &object.Float{Value: fn(values[0])}
+	}
+}
+
+// roundFunction округляет число до целого и возвращает int.
+func roundFunction(name string, fn func(float64) float64) object.BuiltinFunction {
+	return func(env *object.Environment, args ...object.Object) object.Object {
+		if args[0].Type() == object.INTEGER {
+			return args[0]
+		}
+		values, err := floatArgs(name, args)
+		if err != nil {
+			return err
+		}
+		rounded := fn(values[0])
+		if math.IsNaN(rounded) || rounded < math.MinInt64 || rounded >= math.MaxInt64 {
+			return newError("Число %s нельзя округлить до int", args[0].Inspect())
+		}
+		return &object.Integer{Value: int64(rounded)}
+	}
+}
+
+// extremumFunction возвращает min или max: sign равен -1 или 1. Результат
+// int, если все аргументы int.
+func extremumFunction(name string, sign float64) object.BuiltinFunction {
+	return func(env *object.Environment, args ...object.Object) object.Object {
+		if len(args) == 0 {
+			return newError("Функции %s нужен хотя бы один аргумент", name)
+		}
+		values, err := floatArgs(name, args)
+		if err != nil {
+			return err
+		}
+		best := 0
+		for i := range values {
+			if float64(compareNumbers(args, values, i, best))*sign > 0 {
+				best = i
+			}
+		}
+		if allIntegers(args) {
+			return args[best]
+		}
+		return &object.Float{Value: values[best]}
+	}
+}
+
+// compareNumbers сравнивает аргументы i и j: int сравниваются как int,
+// чтобы не терять точность выше 2^53, остальные — как float.
+func compareNumbers(args []object.Object, values []float64, i, j int) int {
+	left, leftInt := args[i].(*object.Integer)
+	right, rightInt := args[j].(*object.Integer)
+	if leftInt && rightInt {
+		return cmp.Compare(left.Value, right.Value)
+	}
+	switch {
+	case values[i] < values[j]:
+		return -1
+	case values[i] > values[j]:
+		return 1
+	}
+	return 0
+}
+
+func mathAbs(env *object.Environment, args ...object.Object) object.Object {
+	switch number := args[0].(type) {
+	case *object.Integer:
+		if number.Value < 0 {
+			return &object.Integer{Value: -number.Value}
+		}
+		return number
+	case *object.Float:
+		return &object.Float{Value: math.Abs(number.Value)}
+	}
+	return newError("Неверный аргумент %s функции abs: ожидается число", args[0].Type())
+}
+
+// mathPow возводит int в неотрицательную степень int точно, иначе
+// считает во float. Результат, не помещающийся в int, — ошибка.
+func mathPow(env *object.Environment, args ...object.Object) object.Object {
+	if allIntegers(args) && args[1].(*object.Integer).Value >= 0 {
+		result, ok := powInt(args[0].(*object.Integer).Value, args[1].(*object.Integer).Value)
+		if !ok {
+			return newError("Результат pow(%s, %s) не помещается в int", args[0].Inspect(), args[1].Inspect())
+		}
+		return &object.Integer{Value: result}
+	}
+	values, err := floatArgs("pow", args)
+	if err != nil {
+		return err
+	}
+	return &object.Float{Value: math.Pow(values[0], values[1])}
+}
+
+// powInt возводит base в степень exp >= 0; ok равно false при переполнении int64.
+func powInt(base, exp int64) (result int64, ok bool) {
+	result = 1
+	for {
+		if exp&1 == 1 {
+			if result, ok = multiplyInt(result, base); !ok {
+				return 0, false
+			}
+		}
+		exp >>= 1
+		if exp == 0 {
+			return result, true
+		}
+		// переполнение квадрата означает переполнение результата
+		if base, ok = multiplyInt(base, base); !ok {
+			return 0, false
+		}
+	}
+}
+
+// multiplyInt умножает a на b; ok равно false при переполнении int64.
+func multiplyInt(a, b int64) (product int64, ok bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	product = a * b
+	if product/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, false
+	}
+	return product, true
+}
+
+func mathAtan2(env *object.Environment, args ...object.Object) object.Object {
+	values, err := floatArgs("atan2", args)
+	if err != nil {
+		return err
+	}
+	return &object.Float{Value: math.Atan2(values[0], values[1])}
+}
+
+func mathGcd(env *object.Environment, args ...object.Object) object.Object {
+	a, b := args[0].(*object.Integer).Value, args[1].(*object.Integer).Value
+	if a < 0 {
+		a = -a
+	}
+	if b < 0 {
+		b = -b
+	}
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return &object.Integer{Value: a}
+}
+
+func mathClamp(env *object.Environment, args ...object.Object) object.Object {
+	values, err := floatArgs("clamp", args)
+	if err != nil {
+		return err
+	}
+	if compareNumbers(args, values, 1, 2) > 0 {
+		return newError("Нижняя граница clamp больше верхней: %s > %s", args[1].Inspect(), args[2].Inspect())
+	}
+	index := 0
+	if compareNumbers(args, values, 0, 1) < 0 {
+		index = 1
+	} else if compareNumbers(args, values, 0, 2) > 0 {
+		index = 2
+	}
+	if allIntegers(args) {
+		return args[index]
+	}
+	return &object.Float{Value: values[index]}
+}
+
+func mathRandom(env *object.Environment, args ...object.Object) object.Object {
+	if err := env.Runtime().CheckRandom(); err != nil {
+		return err
+	}
+	return &object.Float{Value: rand.Float64()}
+}
+
+// mathRandomInt возвращает случайное целое из отрезка [min, max].
+func mathRandomInt(env *object.Environment, args ...object.Object) object.Object {
+	if err := env.Runtime().CheckRandom(); err != nil {
+		return err
+	}
+	lo, hi := args[0].(*object.Integer).Value, args[1].(*object.Integer).Value
+	if lo > hi {
+		return newError("Нижняя граница randomInt больше верхней: %d > %d", lo, hi)
+	}
+	size := hi - lo + 1
+	if size <= 0 {
+		return newError("Слишком большой диапазон randomInt: %d..%d", lo, hi)
+	}
+	return &object.Integer{Value: lo + rand.Int63n(size)}
+}
@@ -0,0 +1,128 @@
+package runner
+
+import (
+	"bytes"
+	"errors"
+	"meow/source/runner/object"
+	"os"
+	"os/exec"
+)
+
+func init() {
+	str := object.STRING
+	functions := moduleFunctions(
+		&object.Builtin{Name: "getEnv", Arity: 1, ParamTypes: []object.ObjectType{str}, Fn: osGetEnv},
+		&object.Builtin{Name: "setEnv", Arity: 2, ParamTypes: []object.ObjectType{str, str}, Fn: osSetEnv},
+		&object.Builtin{Name: "exit", Arity: -1, Fn: builtinExit},
+		&object.Builtin{Name: "cwd", Arity: 0, Fn: osCwd},
+		&object.Builtin{Name: "hostname", Arity: 0, Fn: osHostname},
+		&object.Builtin{Name: "pid", Arity: 0, Fn: osPid},
+		&object.Builtin{Name: "run", Arity: -1, Fn: osRun},
+	)
+	RegisterModuleLoader("os", func(runtime *object.Runtime) map[string]object.Object {
+		members := make(map[string]object.Object, len(functions)+1)
+		for name, function := range functions {
+			members[name] = function
+		}
+		members["args"] = newStringArray(runtime.Args)
+		return members
+	})
+}
+
+// builtinExit завершает программу: exit() или exit(code).
+func builtinExit(env *object.Environment, args ...object.Object) object.Object {
+	if len(args) > 1 {
+		return newError("Неверное число аргументов для функции exit. Ожидается не больше 1, но получено %d", len(args))
+	}
+	if len(args) == 0 {
+		return object.ExitError(0)
+	}
+	code, ok := args[0].(*object.Integer)
+	if !ok {
+		return newError("Неверный аргумент %s функции exit: ожидается INTEGER", args[0].Type())
+	}
+	return object.ExitError(code.Value)
+}
+
+// osGetEnv возвращает значение переменной окружения или null, если её нет.
+func osGetEnv(env *object.Environment, args ...object.Object) object.Object {
+	if err := env.Runtime().CheckEnv(); err != nil {
+		return err
+	}
+	value, ok := os.LookupEnv(goString(args[0]))
+	if !ok {
+		return NULL
+	}
+	return newString(value)
+}
+
+func osSetEnv(env *object.Environment, args ...object.Object) object.Object {
+	if err := env.Runtime().CheckEnv(); err != nil {
+		return err
+	}
+	if err := os.Setenv(goString(args[0]), goString(args[1])); err != nil {
+		return newError("Не удалось задать переменную окружения %s: %s", goString(args[0]), err)
+	}
+	return NULL
+}
+
+func osCwd(env *object.Environment, args ...object.Object) object.Object {
+	if err := env.Runtime().CheckProcess(); err != nil {
+		return err
+	}
+	dir, err := os.Getwd()
+	if err != nil {
+		return newError("Не удалось получить рабочий каталог: %s", err)
+	}
+	return newString(dir)
+}
+
+func osHostname(env *object.Environment, args ...object.Object) object.Object {
+	if err := env.Runtime().CheckProcess(); err != nil {
+		return err
+	}
+	name, err := os.Hostname()
+	if err != nil {
+		return newError("Не удалось получить имя хоста: %s", err)
+	}
+	return newString(name)
+}
+
+func osPid(env *object.Environment, args ...object.Object) object.Object {
+	if err := env.Runtime().CheckProcess(); err != nil {
+		return err
+	}
+	return &object.Integer{Value: int64(os.Getpid())}
+}
+
+// osRun запускает программу и возвращает её вывод: run("git", "status").
+// Ненулевой код завершения становится ошибкой.
+func osRun(env *object.Environment, args ...object.Object) object.Object {
+	if len(args) == 0 {
+		return newError("Функции run нужно имя программы")
+	}
+	names := make([]string, 0, len(args))
+	for _, arg := range args {
+		if arg.Type() != object.STRING {
+			return newError("Неверный аргумент %s функции run: ожидается STRING", arg.Type())
+		}
+		names = append(names, goString(arg))
+	}
+	runtime := env.Runtime()
+	if err := runtime.CheckProcess(); err != nil {
+		return err
+	}
+	var output bytes.Buffer
+	command := exec.CommandContext(runtime.Context(), names[0], names[1:]...)
+	command.Stdin = runtime.Stdin
+	command.Stdout = &output
+	command.Stderr = runtime.Stderr
+	if err := command.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return newError("Программа %s завершилась с кодом %d", names[0], exitErr.ExitCode())
+		}
+		return newError("Не удалось запустить %s: %s", names[0], err)
+	}
+	return newString(output.String())
+}
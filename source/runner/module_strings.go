@@ -0,0 +1,209 @@
+package runner
+
+import (
+	"meow/source/runner/object"
+	"strings"
+	"unicode/utf8"
+)
+
+func init() {
+	str := object.STRING
+	RegisterModule("strings", moduleFunctions(
+		&object.Builtin{Name: "split", Arity: 2, ParamTypes: []object.ObjectType{str, str}, Fn: stringsSplit},
+		&object.Builtin{Name: "join", Arity: 2, ParamTypes: []object.ObjectType{object.ARRAY, str}, Fn: stringsJoin},
+		&object.Builtin{Name: "trim", Arity: 1, ParamTypes: []object.ObjectType{str}, Fn: stringsTrim},
+		&object.Builtin{Name: "replace", Arity: 3, ParamTypes: []object.ObjectType{str, str, str}, Fn: stringsReplace},
+		&object.Builtin{Name: "contains", Arity: 2, ParamTypes: []object.ObjectType{str, str}, Fn: stringsContains},
+		&object.Builtin{Name: "index", Arity: 2, ParamTypes: []object.ObjectType{str, str}, Fn: stringsIndex},
+		&object.Builtin{Name: "startsWith", Arity: 2, ParamTypes: []object.ObjectType{str, str}, Fn: stringsStartsWith},
+		&object.Builtin{Name: "endsWith", Arity: 2, ParamTypes: []object.ObjectType{str, str}, Fn: stringsEndsWith},
+		&object.Builtin{Name: "upper", Arity: 1, ParamTypes: []object.ObjectType{str}, Fn: stringsUpper},
+		&object.Builtin{Name: "lower", Arity: 1, ParamTypes: []object.ObjectType{str}, Fn: stringsLower},
+		&object.Builtin{Name: "repeat", Arity: 2, ParamTypes: []object.ObjectType{str, object.INTEGER}, Fn: stringsRepeat},
+		&object.Builtin{Name: "reverse", Arity: 1, ParamTypes: []object.ObjectType{str}, Fn: stringsReverse},
+		&object.Builtin{Name: "runes", Arity: 1, ParamTypes: []object.ObjectType{str}, Fn: stringsRunes},
+		&object.Builtin{Name: "fromRunes", Arity: 1, ParamTypes: []object.ObjectType{object.ARRAY}, Fn: stringsFromRunes},
+		&object.Builtin{Name: "bytes", Arity: 1, ParamTypes: []object.ObjectType{str}, Fn: stringsBytes},
+		&object.Builtin{Name: "fromBytes", Arity: 1, ParamTypes: []object.ObjectType{object.ARRAY}, Fn: stringsFromBytes},
+	))
+}
+
+// maxStringLength ограничивает длину строк, которые строят встроенные функции.
+const maxStringLength = 1 << 28
+
+func goString(obj object.Object) string {
+	return string(obj.(*object.String).Value)
+}
+
+func newString(s string) *object.String {
+	return &object.String{Value: []rune(s)}
+}
+
+func newStringArray(values []string) *object.Array {
+	elements := make([]object.Object, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, newString(value))
+	}
+	return &object.Array{Elements: elements, ElementsType: object.STRING}
+}
+
+func newIntArray(values []int64) *object.Array {
+	elements := make([]object.Object, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, &object.Integer{Value: value})
+	}
+	return &object.Array{Elements: elements, ElementsType: object.INTEGER}
+}
+
+// elementsOf возвращает элементы массива, проверяя их тип.
+func elementsOf(arr object.Object, elementsType object.ObjectType, fnName string) ([]object.Object, object.Object) {
+	elements := arr.(*object.Array).Elements
+	for _, elem := range elements {
+		if elem.Type() != elementsType {
+			return nil, newError("Функция %s ожидает массив %s, получен элемент %s", fnName, elementsType, elem.Type())
+		}
+	}
+	return elements, nil
+}
+
+func stringsSplit(env *object.Environment, args ...object.Object) object.Object {
+	return newStringArray(strings.Split(goString(args[0]), goString(args[1])))
+}
+
+func stringsJoin(env *object.Environment, args ...object.Object) object.Object {
+	elements, err := elementsOf(args[0], object.STRING, "join")
+	if err != nil {
+		return err
+	}
+	parts := make([]string, 0, len(elements))
+	for _, elem := range elements {
+		parts = append(parts, goString(elem))
+	}
+	return newString(strings.Join(parts, goString(args[1])))
+}
+
+func stringsTrim(env *object.Environment, args ...object.Object) object.Object {
+	return newString(strings.TrimSpace(goString(args[0])))
+}
+
+// stringsReplace заменяет все вхождения old на new. Пустой old вставляет
+// new между всеми символами, поэтому длина результата проверяется заранее.
+func stringsReplace(env *object.Environment, args ...object.Object) object.Object {
+	s, old := goString(args[0]), goString(args[1])
+	length := int64(len(args[0].(*object.String).Value))
+	growth := int64(len(args[2].(*object.String).Value) - len(args[1].(*object.String).Value))
+	if count := int64(strings.Count(s, old)); growth > 0 && count > (maxStringLength-length)/growth {
+		return newError("Результат replace длиннее %d символов", maxStringLength)
+	}
+	if err := env.Runtime().Allocate(); err != nil {
+		return err
+	}
+	return newString(strings.ReplaceAll(s, old, goString(args[2])))
+}
+
+func stringsContains(env *object.Environment, args ...object.Object) object.Object {
+	return nativeBoolToBooleanObject(strings.Contains(goString(args[0]), goString(args[1])))
+}
+
+// stringsIndex возвращает индекс символа, а не байта, как и s[i].
+func stringsIndex(env *object.Environment, args ...object.Object) object.Object {
+	s := goString(args[0])
+	index := strings.Index(s, goString(args[1]))
+	if index > 0 {
+		index = utf8.RuneCountInString(s[:index])
+	}
+	return &object.Integer{Value: int64(index)}
+}
+
+func stringsStartsWith(env *object.Environment, args ...object.Object) object.Object {
+	return nativeBoolToBooleanObject(strings.HasPrefix(goString(args[0]), goString(args[1])))
+}
+
+func stringsEndsWith(env *object.Environment, args ...object.Object) object.Object {
+	return nativeBoolToBooleanObject(strings.HasSuffix(goString(args[0]), goString(args[1])))
+}
+
+func stringsUpper(env *object.Environment, args ...object.Object) object.Object {
+	return newString(strings.ToUpper(goString(args[0])))
+}
+
+func stringsLower(env *object.Environment, args ...object.Object) object.Object {
+	return newString(strings.ToLower(goString(args[0])))
+}
+
+func stringsRepeat(env *object.Environment, args ...object.Object) object.Object {
+	count := args[1].(*object.Integer).Value
+	if count < 0 {
+		return newError("Число повторений не может быть отрицательным: %d", count)
+	}
+	length := int64(len(args[0].(*object.String).Value))
+	if length > 0 && count > maxStringLength/length {
+		return newError("Результат repeat длиннее %d символов", maxStringLength)
+	}
+	if err := env.Runtime().Allocate(); err != nil {
+		return err
+	}
+	return newString(strings.Repeat(goString(args[0]), int(count)))
+}
+
+func stringsReverse(env *object.Environment, args ...object.Object) object.Object {
+	runes := args[0].(*object.String).Value
+	reversed := make([]rune, len(runes))
+	for i, r := range runes {
+		reversed[len(runes)-1-i] = r
+	}
+	return &object.String{Value: reversed}
+}
+
+func stringsRunes(env *object.Environment, args ...object.Object) object.Object {
+	runes := args[0].(*object.String).Value
+	codes := make([]int64, 0, len(runes))
+	for _, r := range runes {
+		codes = append(codes, int64(r))
+	}
+	return newIntArray(codes)
+}
+
+func stringsFromRunes(env *object.Environment, args ...object.Object) object.Object {
+	elements, err := elementsOf(args[0], object.INTEGER, "fromRunes")
+	if err != nil {
+		return err
+	}
+	runes := make([]rune, 0, len(elements))
+	for _, elem := range elements {
+		code := elem.(*object.Integer).Value
+		if code < 0 || code > utf8.MaxRune {
+			return newError("Неверный код символа: %d", code)
+		}
+		runes = append(runes, rune(code))
+	}
+	return &object.String{Value: runes}
+}
+
+func stringsBytes(env *object.Environment, args ...object.Object) object.Object {
+	bytes := []byte(goString(args[0]))
+	values := make([]int64, 0, len(bytes))
+	for _, b := range bytes {
+		values = append(values, int64(b))
+	}
+	return newIntArray(values)
+}
+
+func stringsFromBytes(env *object.Environment, args ...object.Object) object.Object {
+	elements, err := elementsOf(args[0], object.INTEGER, "fromBytes")
+	if err != nil {
+		return err
+	}
+	bytes := make([]byte, 0, len(elements))
+	for _, elem := range elements {
+		value := elem.(*object.Integer).Value
+		if value < 0 || value > 255 {
+			return newError("Неверное значение байта: %d", value)
+		}
+		bytes = append(bytes, byte(value))
+	}
+	if !utf8.Valid(bytes) {
+		return newError("Байты не являются строкой UTF-8")
+	}
+	return newString(string(bytes))
+}
@@ -0,0 +1,20 @@
+package runner
+
+import (
+	"meow/source/runner/object"
+	"time"
+)
+
+func init() {
+	RegisterModule("time", moduleFunctions(
+		&object.Builtin{Name: "now", Arity: 0, Fn: timeNow},
+	))
+}
+
+// timeNow возвращает текущее время в миллисекундах с начала эпохи Unix.
+func timeNow(env *object.Environment, args ...object.Object) object.Object {
+	if err := env.Runtime().CheckClock(); err != nil {
+		return err
+	}
+	return &object.Integer{Value: time.Now().UnixMilli()}
+}
@@ -0,0 +1,47 @@
+package runner
+
+import (
+	"meow/source/runner/object"
+)
+
+// ModuleLoader создаёт члены модуля при импорте; так модуль может
+// зависеть от настроек запуска.
+type ModuleLoader func(runtime *object.Runtime) map[string]object.Object
+
+// nativeModules — модули, реализованные на Go. Они импортируются по имени:
+// import strings "strings";
+var nativeModules = map[string]ModuleLoader{}
+
+// RegisterModule добавляет модуль на Go или заменяет модуль с тем же именем.
+func RegisterModule(name string, members map[string]object.Object) {
+	RegisterModuleLoader(name, func(*object.Runtime) map[string]object.Object {
+		return members
+	})
+}
+
+func RegisterModuleLoader(name string, loader ModuleLoader) {
+	nativeModules[name] = loader
+}
+
+// moduleFunctions собирает члены модуля из встроенных функций.
+func moduleFunctions(functions ...*object.Builtin) map[string]object.Object {
+	members := make(map[string]object.Object, len(functions))
+	for _, function := range functions {
+		members[function.Name] = function
+	}
+	return members
+}
+
+func importNativeModule(name string, loader ModuleLoader, env *object.Environment) object.Object {
+	enviroment := object.NewEnvironment()
+	enviroment.SetRuntime(env.Runtime())
+	for memberName, member := range loader(env.Runtime()) {
+		enviroment.Set(memberName, member)
+	}
+	module := &object.Module{
+		Name:        name,
+		Environment: *enviroment,
+	}
+	env.Set(name, module)
+	return nil
+}
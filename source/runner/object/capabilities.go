@@ -0,0 +1,87 @@
+package object
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// Capabilities определяют, к чему программа имеет доступ на хосте.
+// Встроенные функции и import проверяют их перед обращением к системе.
+type Capabilities struct {
+	// ReadAny и WriteAny снимают ограничения на пути; иначе доступны
+	// только файлы внутри ReadRoots и WriteRoots.
+	ReadAny    bool
+	WriteAny   bool
+	ReadRoots  []string
+	WriteRoots []string
+	Env        bool
+	Process    bool
+	Clock      bool
+	Random     bool
+}
+
+// FullAccess разрешает всё; так запускаются программы по умолчанию.
+func FullAccess() Capabilities {
+	return Capabilities{
+		ReadAny:  true,
+		WriteAny: true,
+		Env:      true,
+		Process:  true,
+		Clock:    true,
+		Random:   true,
+	}
+}
+
+// Sandbox разрешает только чтение файлов внутри roots.
+func Sandbox(roots ...string) Capabilities {
+	return Capabilities{ReadRoots: roots}
+}
+
+func (c Capabilities) CanRead(path string) bool {
+	return c.ReadAny || insideRoots(path, c.ReadRoots)
+}
+
+func (c Capabilities) CanWrite(path string) bool {
+	return c.WriteAny || insideRoots(path, c.WriteRoots)
+}
+
+func insideRoots(path string, roots []string) bool {
+	path, err := resolvePath(path)
+	if err != nil {
+		return false
+	}
+	for _, root := range roots {
+		root, err := resolvePath(root)
+		if err != nil {
+			continue
+		}
+		rel, err := filepath.Rel(root, path)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolvePath делает путь абсолютным и раскрывает символические ссылки,
+// чтобы ссылка внутри корня не вела за его пределы. У ещё не созданного
+// файла раскрывается ближайший существующий каталог.
+func resolvePath(path string) (string, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	resolved, err := filepath.EvalSymlinks(path)
+	if err == nil {
+		return resolved, nil
+	}
+	parent := filepath.Dir(path)
+	if parent == path {
+		return path, nil
+	}
+	resolvedParent, err := resolvePath(parent)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(resolvedParent, filepath.Base(path)), nil
+}
@@ -0,0 +1,63 @@
+package object
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestInsideRootsRejectsParentTraversal(t *testing.T) {
+	root := t.TempDir()
+	if !insideRoots(filepath.Join(root, "a.txt"), []string{root}) {
+		t.Fatal("файл внутри корня должен быть доступен")
+	}
+	if !insideRoots(filepath.Join(root, "new", "b.txt"), []string{root}) {
+		t.Fatal("ещё не созданный файл внутри корня должен быть доступен")
+	}
+	if insideRoots(filepath.Join(root, "..", "a.txt"), []string{root}) {
+		t.Fatal("путь через .. вышел за пределы корня")
+	}
+	if insideRoots(root+"-other", []string{root}) {
+		t.Fatal("каталог с тем же префиксом не должен считаться корнем")
+	}
+}
+
+func TestInsideRootsRejectsSymlinkEscape(t *testing.T) {
+	root := t.TempDir()
+	outside := t.TempDir()
+	if err := os.WriteFile(filepath.Join(outside, "secret.txt"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	link := filepath.Join(root, "link")
+	if err := os.Symlink(outside, link); err != nil {
+		t.Skip("символические ссылки недоступны:", err)
+	}
+	if insideRoots(filepath.Join(link, "secret.txt"), []string{root}) {
+		t.Fatal("ссылка внутри корня вывела за его пределы")
+	}
+	if insideRoots(filepath.Join(link, "new.txt"), []string{root}) {
+		t.Fatal("новый файл за ссылкой оказался внутри корня")
+	}
+}
+
+func TestResolvePathFollowsSymlinkedRoot(t *testing.T) {
+	target := t.TempDir()
+	link := filepath.Join(t.TempDir(), "root")
+	if err := os.Symlink(target, link); err != nil {
+		t.Skip("символические ссылки недоступны:", err)
+	}
+	resolved, err := resolvePath(filepath.Join(link, "a.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := filepath.EvalSymlinks(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved != filepath.Join(want, "a.txt") {
+		t.Fatalf("получено %s, ожидалось %s", resolved, filepath.Join(want, "a.txt"))
+	}
+	if !insideRoots(filepath.Join(target, "a.txt"), []string{link}) {
+		t.Fatal("корень-ссылка должен принимать файлы из своего каталога")
+	}
+}
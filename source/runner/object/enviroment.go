@@ -1,17 +1,27 @@
 package object
 
 type Environment struct {
-	errors []Error
-	store  map[string]Object
-	outer  *Environment
+	errors    []Error
+	store     map[string]Object
+	private   map[string]bool
+	className string
+	runtime   *Runtime
+	outer     *Environment
 }
 
 func NewEnvironment() *Environment {
+	env := newEnvironment()
+	env.runtime = NewRuntime()
+	return env
+}
+
+func newEnvironment() *Environment {
 	s := make(map[string]Object)
 	return &Environment{
-		store:  s,
-		outer:  nil,
-		errors: make([]Error, 0),
+		store:   s,
+		private: make(map[string]bool),
+		outer:   nil,
+		errors:  make([]Error, 0),
 	}
 }
 
@@ -40,12 +50,46 @@ func (e *Environment) Set(name string, value Object) Object {
 	return value
 }
 
+// MarkPrivate скрывает имя от модулей, импортирующих это окружение.
+func (e *Environment) MarkPrivate(name string) {
+	e.private[name] = true
+}
+
+func (e *Environment) IsPrivate(name string) bool {
+	return e.private[name]
+}
+
+// SetClassName отмечает окружение как тело метода класса.
+func (e *Environment) SetClassName(name string) {
+	e.className = name
+}
+
+// ClassName возвращает класс, в методе которого выполняется код.
+func (e *Environment) ClassName() string {
+	if e.className == "" && e.outer != nil {
+		return e.outer.ClassName()
+	}
+	return e.className
+}
+
 func NewEnclosedEnvironment(outer *Environment) *Environment {
-	env := NewEnvironment()
+	env := newEnvironment()
 	env.outer = outer
+	env.runtime = outer.runtime
 	return env
 }
 
+// Runtime возвращает настройки запуска, к которому относится окружение.
+func (e *Environment) Runtime() *Runtime {
+	return e.runtime
+}
+
+// SetRuntime подключает окружение к запуску, например окружение модуля
+// к запуску импортирующей его программы.
+func (e *Environment) SetRuntime(runtime *Runtime) {
+	e.runtime = runtime
+}
+
 
 func (e *Environment) Errors() []Error {
 	return e.errors
@@ -3,7 +3,10 @@ package object
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"meow/source/ast"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/sanity-io/litter"
@@ -17,10 +20,17 @@ const (
 	NULL         ObjectType = "NULL"
 	STRING       ObjectType = "STRING"
 	RETURN_VALUE ObjectType = "RETURN_VALUE"
+	TUPLE        ObjectType = "TUPLE"
 	ERROR        ObjectType = "ERROR"
 	FUNCTION     ObjectType = "FUNCTION"
+	BUILTIN      ObjectType = "BUILTIN"
 	ARRAY        ObjectType = "ARRAY"
+	MAP          ObjectType = "MAP"
 	CLASS        ObjectType = "CLASS"
+	INTERFACE    ObjectType = "INTERFACE"
+	ENUM         ObjectType = "ENUM"
+	ENUM_VALUE   ObjectType = "ENUM_VALUE"
+	SUPER        ObjectType = "SUPER"
 	MODULE       ObjectType = "MODULE"
 	FLOAT        ObjectType = "FLOAT"
 )
@@ -38,8 +48,18 @@ type Float struct {
 	Value float64
 }
 
+// Inspect выводит самое короткое представление, из которого читается
+// то же число; у целых значений остаётся ".0", чтобы отличать их от int.
 func (f *Float) Inspect() string {
-	return fmt.Sprintf("%f", f.Value)
+	format := byte('f')
+	if abs := math.Abs(f.Value); abs != 0 && (abs < 1e-4 || abs >= 1e21) {
+		format = 'g'
+	}
+	text := strconv.FormatFloat(f.Value, format, -1, 64)
+	if !strings.ContainsAny(text, ".eIN") {
+		text += ".0"
+	}
+	return text
 }
 
 func (f *Float) Type() ObjectType {
@@ -105,8 +125,34 @@ func (r *ReturnValue) Inspect() string {
 	return out.String()
 }
 
+// Tuple хранит несколько значений, возвращённых функцией.
+type Tuple struct {
+	Elements []Object
+}
+
+func (t *Tuple) Type() ObjectType {
+	return TUPLE
+}
+
+func (t *Tuple) Inspect() string {
+	elements := []string{}
+	for _, e := range t.Elements {
+		elements = append(elements, e.Inspect())
+	}
+	return strings.Join(elements, ", ")
+}
+
+// Error прерывает выполнение до ближайшего блока catch. Value хранит
+// значение, переданное в throw, а Stack — функции, через которые
+// прошла ошибка. Fatal-ошибку, например превышение лимитов, catch
+// не перехватывает. Exit означает вызов exit(ExitCode).
 type Error struct {
-	Message string
+	Message  string
+	Value    Object
+	Stack    []string
+	Fatal    bool
+	Exit     bool
+	ExitCode int64
 }
 
 func (e *Error) Type() ObjectType {
@@ -118,13 +164,16 @@ func (e *Error) Inspect() string {
 }
 
 type FunctionLiteral struct {
-	Env        *Environment
-	Parameters []ast.VariableDecStatement
-	ReturnType []ObjectType
-	Body       *ast.BlockStatement
-	IsMethod   bool
-	ClassName  string
- }
+	Name           string
+	TypeParameters []string
+	Env            *Environment
+	Parameters     []ast.VariableDecStatement
+	ReturnType     []ast.Type
+	Body           *ast.BlockStatement
+	IsMethod       bool
+	ClassName      string
+	SuperClass     *Class
+}
 
 func (fl *FunctionLiteral) Type() ObjectType {
 	return FUNCTION
@@ -145,6 +194,27 @@ func (fl *FunctionLiteral) Inspect() string {
 	return out.String()
 }
 
+// BuiltinFunction — реализация встроенной функции на Go; env — окружение
+// вызова, через него доступны настройки запуска.
+type BuiltinFunction func(env *Environment, args ...Object) Object
+
+// Builtin — встроенная функция. Arity -1 означает любое число аргументов;
+// пустой тип в ParamTypes допускает аргумент любого типа.
+type Builtin struct {
+	Name       string
+	Arity      int
+	ParamTypes []ObjectType
+	Fn         BuiltinFunction
+}
+
+func (b *Builtin) Type() ObjectType {
+	return BUILTIN
+}
+
+func (b *Builtin) Inspect() string {
+	return "builtin " + b.Name
+}
+
 type Array struct {
 	Elements     []Object
 	ElementsType ObjectType
@@ -158,6 +228,10 @@ func (a *Array) Inspect() string {
 	var out bytes.Buffer
 	elements := []string{}
 	for _, e := range a.Elements {
+		if e.Type() == TUPLE {
+			elements = append(elements, "("+e.Inspect()+")")
+			continue
+		}
 		elements = append(elements, e.Inspect())
 	}
 	out.WriteString("[")
@@ -167,11 +241,80 @@ func (a *Array) Inspect() string {
 	return out.String()
 }
 
+// MapKey — ключ словаря: значения разных типов с одинаковой записью
+// считаются разными ключами.
+type MapKey struct {
+	Type  ObjectType
+	Value string
+}
+
+// KeyOf возвращает ключ словаря для значения; ключами могут быть
+// только числа, строки и логические значения.
+func KeyOf(obj Object) (MapKey, bool) {
+	switch obj.Type() {
+	case INTEGER, FLOAT, STRING, BOOLEAN:
+		return MapKey{Type: obj.Type(), Value: obj.Inspect()}, true
+	}
+	return MapKey{}, false
+}
+
+// Map хранит пары в порядке добавления ключей.
+type Map struct {
+	Keys      []Object
+	Values    map[MapKey]Object
+	KeyType   ObjectType
+	ValueType ObjectType
+}
+
+func (m *Map) Type() ObjectType {
+	return MAP
+}
+
+func (m *Map) Inspect() string {
+	pairs := make([]string, 0, len(m.Keys))
+	for _, key := range m.Keys {
+		hash, _ := KeyOf(key)
+		pairs = append(pairs, key.Inspect()+": "+m.Values[hash].Inspect())
+	}
+	return "{" + strings.Join(pairs, ", ") + "}"
+}
+
+func (m *Map) Get(key Object) (Object, bool) {
+	hash, ok := KeyOf(key)
+	if !ok {
+		return nil, false
+	}
+	value, ok := m.Values[hash]
+	return value, ok
+}
+
+func (m *Map) Set(key, value Object) {
+	hash, _ := KeyOf(key)
+	if _, exists := m.Values[hash]; !exists {
+		m.Keys = append(m.Keys, key)
+	}
+	m.Values[hash] = value
+}
+
+// Class описывает и сам класс, и его экземпляры: у экземпляра Class
+// указывает на класс, из которого он создан. TypeArguments экземпляра
+// хранит типы, подставленные вместо параметров типа класса.
 type Class struct {
-	OriginName string
-	Name       string
-	Fields     map[string]Object
-	Functions  map[string]Object
+	OriginName      string
+	Name            string
+	TypeParameters  []string
+	TypeArguments   map[string]ast.Type
+	Env             *Environment
+	Class           *Class
+	Parent          *Class
+	Interfaces      []*Interface
+	Fields          map[string]Object
+	Declarations    map[string]ast.ClassFieldStatement
+	Functions       map[string]Object
+	StaticFields    map[string]Object
+	StaticFunctions map[string]Object
+	Private         map[string]bool
+	Constructor     *FunctionLiteral
 }
 
 func (c *Class) Type() ObjectType {
@@ -179,17 +322,101 @@ func (c *Class) Type() ObjectType {
 }
 
 func (c *Class) Inspect() string {
-	var out bytes.Buffer
-	out.WriteString("class " + c.Name + " {\n")
-	for _, f := range c.Fields {
-		out.WriteString(f.Inspect() + "\n")
+	if c.Class == nil {
+		return "class " + c.Name
 	}
-	for _, f := range c.Functions {
-		out.WriteString(f.Inspect() + "\n")
+	names := make([]string, 0, len(c.Fields))
+	for name := range c.Fields {
+		names = append(names, name)
 	}
-	out.WriteString("}")
+	sort.Strings(names)
+	fields := make([]string, 0, len(names))
+	for _, name := range names {
+		fields = append(fields, name+": "+c.Fields[name].Inspect())
+	}
+	return c.Name + "(" + strings.Join(fields, ", ") + ")"
+}
 
-	return out.String()
+// Interface описывает набор методов, которые обязан реализовать класс.
+type Interface struct {
+	Name      string
+	Functions map[string]ast.ClassFunctionStatement
+}
+
+func (i *Interface) Type() ObjectType {
+	return INTERFACE
+}
+
+func (i *Interface) Inspect() string {
+	names := []string{}
+	for name := range i.Functions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return "interface " + i.Name + " (" + strings.Join(names, ", ") + ")"
+}
+
+// Enum — объявленное перечисление; Variants хранит варианты в порядке
+// объявления вместе с типами их данных.
+type Enum struct {
+	Name     string
+	Variants []ast.EnumVariantStatement
+}
+
+func (e *Enum) Type() ObjectType {
+	return ENUM
+}
+
+func (e *Enum) Inspect() string {
+	names := []string{}
+	for _, v := range e.Variants {
+		names = append(names, v.Name)
+	}
+	return "enum " + e.Name + " (" + strings.Join(names, ", ") + ")"
+}
+
+func (e *Enum) Variant(name string) (ast.EnumVariantStatement, bool) {
+	for _, v := range e.Variants {
+		if v.Name == name {
+			return v, true
+		}
+	}
+	return ast.EnumVariantStatement{}, false
+}
+
+type EnumValue struct {
+	Enum    *Enum
+	Variant string
+	Values  []Object
+}
+
+func (ev *EnumValue) Type() ObjectType {
+	return ENUM_VALUE
+}
+
+func (ev *EnumValue) Inspect() string {
+	if len(ev.Values) == 0 {
+		return ev.Variant
+	}
+	values := []string{}
+	for _, v := range ev.Values {
+		values = append(values, v.Inspect())
+	}
+	return ev.Variant + "(" + strings.Join(values, ", ") + ")"
+}
+
+// Super даёт методу наследника доступ к реализации родительского класса.
+type Super struct {
+	Instance *Class
+	Class    *Class
+}
+
+func (s *Super) Type() ObjectType {
+	return SUPER
+}
+
+func (s *Super) Inspect() string {
+	return "super " + s.Class.Name
 }
 
 type Field struct {
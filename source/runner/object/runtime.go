@@ -0,0 +1,193 @@
+package object
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// Runtime хранит настройки одного запуска программы. Его разделяют все
+// окружения программы, включая окружения импортированных модулей.
+type Runtime struct {
+	Stdin        io.Reader
+	Stdout       io.Writer
+	Stderr       io.Writer
+	Limits       Limits
+	Capabilities Capabilities
+	// Args — аргументы командной строки, переданные программе.
+	Args []string
+
+	// lines читает построчно из Stdin; пересоздаётся, если Stdin заменили.
+	lines       *bufio.Reader
+	linesSource io.Reader
+
+	ctx         context.Context
+	deadline    time.Time
+	steps       int64
+	depth       int64
+	allocations int64
+}
+
+// Limits ограничивают выполнение программы. Нулевое значение поля
+// означает отсутствие ограничения; лишь для MaxCallDepth ноль означает
+// глубину по умолчанию, ведь без неё рекурсия переполняет стек Go.
+type Limits struct {
+	MaxSteps       int64
+	MaxCallDepth   int64
+	MaxAllocations int64
+	Timeout        time.Duration
+}
+
+// DefaultLimits не дают глубокой рекурсии переполнить стек Go.
+var DefaultLimits = Limits{MaxCallDepth: defaultCallDepth}
+
+const defaultCallDepth = 10000
+
+func NewRuntime() *Runtime {
+	return &Runtime{
+		Stdin:        os.Stdin,
+		Stdout:       os.Stdout,
+		Stderr:       os.Stderr,
+		Limits:       DefaultLimits,
+		Capabilities: FullAccess(),
+		ctx:          context.Background(),
+	}
+}
+
+// Begin начинает новый запуск: сбрасывает счётчики и отсчёт времени.
+// Выполнение прерывается, когда ctx отменён.
+func (r *Runtime) Begin(ctx context.Context) {
+	r.ctx = ctx
+	r.steps, r.depth, r.allocations = 0, 0, 0
+	r.deadline = time.Time{}
+	if r.Limits.Timeout > 0 {
+		r.deadline = time.Now().Add(r.Limits.Timeout)
+	}
+}
+
+// Context возвращает контекст текущего запуска.
+func (r *Runtime) Context() context.Context {
+	return r.ctx
+}
+
+// Step учитывает выполнение одной инструкции.
+func (r *Runtime) Step() *Error {
+	r.steps++
+	if r.Limits.MaxSteps > 0 && r.steps > r.Limits.MaxSteps {
+		return limitError("Превышено число шагов выполнения: %d", r.Limits.MaxSteps)
+	}
+	if !r.deadline.IsZero() && time.Now().After(r.deadline) {
+		return limitError("Превышено время выполнения: %s", r.Limits.Timeout)
+	}
+	if err := r.ctx.Err(); err != nil {
+		return limitError("Выполнение прервано: %s", err)
+	}
+	return nil
+}
+
+// Enter учитывает вход в функцию; после выхода нужно вызвать Leave.
+func (r *Runtime) Enter() *Error {
+	r.depth++
+	limit := r.Limits.MaxCallDepth
+	if limit <= 0 {
+		limit = defaultCallDepth
+	}
+	if r.depth > limit {
+		r.depth--
+		return limitError("Превышена глубина вызовов: %d", limit)
+	}
+	return nil
+}
+
+func (r *Runtime) Leave() {
+	r.depth--
+}
+
+// Allocate учитывает создание массива, словаря или объекта.
+func (r *Runtime) Allocate() *Error {
+	r.allocations++
+	if r.Limits.MaxAllocations > 0 && r.allocations > r.Limits.MaxAllocations {
+		return limitError("Превышено число созданных объектов: %d", r.Limits.MaxAllocations)
+	}
+	return nil
+}
+
+// ReadLine читает строку из Stdin без перевода строки. ok равно false,
+// если ввод закончился.
+func (r *Runtime) ReadLine() (line string, ok bool, err error) {
+	if r.lines == nil || r.linesSource != r.Stdin {
+		r.lines = bufio.NewReader(r.Stdin)
+		r.linesSource = r.Stdin
+	}
+	line, err = r.lines.ReadString('\n')
+	if err == io.EOF {
+		return line, line != "", nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	line = strings.TrimSuffix(line, "\n")
+	return strings.TrimSuffix(line, "\r"), true, nil
+}
+
+// CheckRead проверяет, что программе разрешено читать файл.
+func (r *Runtime) CheckRead(path string) *Error {
+	if !r.Capabilities.CanRead(path) {
+		return deniedError("чтение %s вне разрешённых каталогов", path)
+	}
+	return nil
+}
+
+// CheckWrite проверяет, что программе разрешено изменять файл.
+func (r *Runtime) CheckWrite(path string) *Error {
+	if !r.Capabilities.CanWrite(path) {
+		return deniedError("запись в %s вне разрешённых каталогов", path)
+	}
+	return nil
+}
+
+func (r *Runtime) CheckEnv() *Error {
+	if !r.Capabilities.Env {
+		return deniedError("переменные окружения")
+	}
+	return nil
+}
+
+func (r *Runtime) CheckProcess() *Error {
+	if !r.Capabilities.Process {
+		return deniedError("процессы")
+	}
+	return nil
+}
+
+func (r *Runtime) CheckClock() *Error {
+	if !r.Capabilities.Clock {
+		return deniedError("текущее время")
+	}
+	return nil
+}
+
+func (r *Runtime) CheckRandom() *Error {
+	if !r.Capabilities.Random {
+		return deniedError("случайные числа")
+	}
+	return nil
+}
+
+func deniedError(format string, a ...any) *Error {
+	return &Error{Message: "Доступ запрещён: " + fmt.Sprintf(format, a...)}
+}
+
+// ExitError завершает программу с кодом code. Как и превышение лимитов,
+// выход не перехватывается catch, но блоки finally выполняются.
+func ExitError(code int64) *Error {
+	return &Error{Message: fmt.Sprintf("Выход с кодом %d", code), Fatal: true, Exit: true, ExitCode: code}
+}
+
+func limitError(format string, a ...any) *Error {
+	return &Error{Message: fmt.Sprintf(format, a...), Fatal: true}
+}
@@ -0,0 +1,169 @@
+package runner
+
+import (
+	"meow/source/lexer"
+	"meow/source/runner/object"
+	"strings"
+)
+
+// Специальные методы, которыми класс переопределяет поведение операторов,
+// вывода и индексации своих объектов.
+const (
+	toStringMethod = "toString"
+	equalsMethod   = "equals"
+	compareMethod  = "compare"
+	indexMethod    = "index"
+)
+
+var arithmeticMethods = map[lexer.TokenKind]string{
+	lexer.PLUS:  "add",
+	lexer.MINUS: "subtract",
+	lexer.MUL:   "multiply",
+	lexer.DIV:   "divide",
+}
+
+// findMethod возвращает метод объекта класса, если объект его определяет.
+func findMethod(obj object.Object, name string) (*object.Class, *object.FunctionLiteral) {
+	instance, ok := obj.(*object.Class)
+	if !ok || instance.Class == nil {
+		return nil, nil
+	}
+	fn, ok := instance.Functions[name].(*object.FunctionLiteral)
+	if !ok {
+		return nil, nil
+	}
+	return instance, fn
+}
+
+func callSpecialMethod(instance *object.Class, fn *object.FunctionLiteral, name string, args ...object.Object) object.Object {
+	if len(fn.Parameters) != len(args) {
+		return newError("Метод %s класса %s должен принимать аргументов: %d", name, instance.Name, len(args))
+	}
+	bindings := newTypeBindings(fn.TypeParameters, instance)
+	for i, arg := range args {
+		if !matchType(arg, fn.Parameters[i].Type, bindings) {
+			return newError("Неверный аргумент %s для параметра %s.", arg.Type(), typeName(fn.Parameters[i].Type))
+		}
+	}
+	return applyFunction(fn, append([]object.Object{instance}, args...))
+}
+
+func evalClassBOExpression(operator lexer.TokenKind, left, right object.Object) object.Object {
+	if left.Type() == object.STRING && operator == lexer.PLUS {
+		text, err := toDisplayString(right)
+		if err != nil {
+			return err
+		}
+		return &object.String{Value: append([]rune(string(left.(*object.String).Value)), []rune(text)...)}
+	}
+	switch operator {
+	case lexer.EQUALS, lexer.NOT_EQUALS:
+		equal := left == right
+		instance, fn := findMethod(left, equalsMethod)
+		if fn != nil && len(fn.Parameters) == 1 && !checkParamType(right, fn.Parameters[0].Type) {
+			fn = nil
+		}
+		if fn != nil {
+			result := callSpecialMethod(instance, fn, equalsMethod, right)
+			if IsError(result) {
+				return result
+			}
+			if result.Type() != object.BOOLEAN {
+				return newError("Метод %s класса %s должен возвращать bool", equalsMethod, instance.Name)
+			}
+			equal = result == TRUE
+		}
+		if operator == lexer.NOT_EQUALS {
+			return nativeBoolToBooleanObject(!equal)
+		}
+		return nativeBoolToBooleanObject(equal)
+	case lexer.LESS, lexer.LESS_EQUALS, lexer.GREATER, lexer.GREATER_EQUALS:
+		instance, fn := findMethod(left, compareMethod)
+		if fn == nil {
+			return newError("Невозможно сравнить объекты %s и %s", left.Type(), right.Type())
+		}
+		result := callSpecialMethod(instance, fn, compareMethod, right)
+		if IsError(result) {
+			return result
+		}
+		order, ok := result.(*object.Integer)
+		if !ok {
+			return newError("Метод %s класса %s должен возвращать int", compareMethod, instance.Name)
+		}
+		return evalIntegerBOExpression(operator, order, &object.Integer{Value: 0})
+	}
+	name, ok := arithmeticMethods[operator]
+	if !ok {
+		return newError("Невозможно бинарное действие типов %s, %s", left.Type(), right.Type())
+	}
+	instance, fn := findMethod(left, name)
+	if fn == nil {
+		return newError("Невозможно бинарное действие типов %s, %s", left.Type(), right.Type())
+	}
+	return callSpecialMethod(instance, fn, name, right)
+}
+
+// toDisplayString переводит объект в строку для вывода, вызывая toString
+// у объектов классов, которые его определяют.
+func toDisplayString(obj object.Object) (string, object.Object) {
+	if obj == nil {
+		return NULL.Inspect(), nil
+	}
+	switch obj := obj.(type) {
+	case *object.Class:
+		instance, fn := findMethod(obj, toStringMethod)
+		if fn == nil {
+			return obj.Inspect(), nil
+		}
+		result := callSpecialMethod(instance, fn, toStringMethod)
+		if IsError(result) {
+			return "", result
+		}
+		if result.Type() != object.STRING {
+			return "", newError("Метод %s класса %s должен возвращать string", toStringMethod, instance.Name)
+		}
+		return result.Inspect(), nil
+	case *object.Array:
+		elements, err := toDisplayStrings(obj.Elements)
+		if err != nil {
+			return "", err
+		}
+		// Кортежи внутри массива, например пары из zip, выводятся в скобках.
+		for i, elem := range obj.Elements {
+			if elem.Type() == object.TUPLE {
+				elements[i] = "(" + elements[i] + ")"
+			}
+		}
+		return "[" + strings.Join(elements, ", ") + "]", nil
+	case *object.Tuple:
+		elements, err := toDisplayStrings(obj.Elements)
+		if err != nil {
+			return "", err
+		}
+		return strings.Join(elements, ", "), nil
+	case *object.Map:
+		pairs := make([]string, 0, len(obj.Keys))
+		for _, key := range obj.Keys {
+			value, _ := obj.Get(key)
+			text, err := toDisplayString(value)
+			if err != nil {
+				return "", err
+			}
+			pairs = append(pairs, key.Inspect()+": "+text)
+		}
+		return "{" + strings.Join(pairs, ", ") + "}", nil
+	}
+	return obj.Inspect(), nil
+}
+
+func toDisplayStrings(objs []object.Object) ([]string, object.Object) {
+	out := make([]string, 0, len(objs))
+	for _, obj := range objs {
+		text, err := toDisplayString(obj)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, text)
+	}
+	return out, nil
+}